@@ -0,0 +1,169 @@
+package main
+
+import (
+	"flag"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+// stdout where everything shown to the player is written (the terminal and,
+// with -speech, a plain text copy for speech synthesis).
+var stdout io.Writer = os.Stdout
+
+// accessible output for screen readers: the screen is never cleared, boards
+// are described instead of drawn, every announcement gets its own line and
+// squares are written the way they are said (ex "e four").
+var accessible bool
+
+// speech plain text copy of the output for speech synthesis (nil without -speech).
+var speech *plainText
+
+// ansiCodes escape codes for clearing the screen and colouring squares.
+var ansiCodes = regexp.MustCompile("\033\\[[0-9;]*[A-Za-z]")
+
+// plainText Writer that drops ANSI escape codes so that speech synthesis
+// only gets the words.
+type plainText struct {
+	w io.WriteCloser
+	// lineOpen the last text written didn't end with a new line (ex a prompt)
+	lineOpen bool
+}
+
+func (p *plainText) Write(data []byte) (int, error) {
+	text := ansiCodes.ReplaceAll(data, nil)
+	if len(text) > 0 {
+		p.lineOpen = text[len(text)-1] != '\n'
+	}
+
+	if _, err := p.w.Write(text); err != nil {
+		return 0, err
+	}
+	return len(data), nil
+}
+
+// endLine Ends a prompt once the player answered it. The terminal shows the
+// answer and a new line, the plain text copy would run on into the next announcement.
+func (p *plainText) endLine() {
+	if p.lineOpen {
+		io.WriteString(p.w, "\n")
+		p.lineOpen = false
+	}
+}
+
+// close Ends the last line and closes the file, a reader of a named pipe
+// only sees the end of the output once it is closed.
+func (p *plainText) close() error {
+	p.endLine()
+	return p.w.Close()
+}
+
+// closeSpeech Closes the plain text copy of the output (if there is one).
+func closeSpeech() {
+	if speech != nil {
+		speech.close()
+		speech = nil
+		stdout = os.Stdout
+	}
+}
+
+// exit Closes the plain text copy of the output and stops the program with
+// the status code (os.Exit skips deferred calls).
+func exit(code int) {
+	closeSpeech()
+	os.Exit(code)
+}
+
+// accessibleFlags Adds the -accessible and -speech flags to flags.
+func accessibleFlags(flags *flag.FlagSet) {
+	flags.BoolVar(
+		&accessible,
+		"accessible",
+		false,
+		"screen reader friendly output: no screen clearing, one announcement per line, spoken squares",
+	)
+	flags.Func(
+		"speech",
+		"also write everything shown as plain text to this file or named pipe (for text to speech)",
+		func(path string) error {
+			file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+			if err != nil {
+				return err
+			}
+
+			speech = &plainText{w: file}
+			stdout = io.MultiWriter(os.Stdout, speech)
+			return nil
+		},
+	)
+}
+
+// notation Get the square the way it is announced ("e4" or "e four" in accessible mode).
+func notation(square *game.Square) string {
+	if accessible {
+		return square.SpokenNotation()
+	}
+	return square.Notation()
+}
+
+// printList Prints a titled list (ex "Moves: Nf3, e4"), one item per line in accessible mode.
+func printList(title string, items []string) {
+	if !accessible {
+		messages.Fprintf(stdout, "%s: %s\n", messages.Text(title), strings.Join(items, ", "))
+		return
+	}
+
+	messages.Fprintf(stdout, "%s:\n", messages.Text(title))
+	for _, item := range items {
+		messages.Fprintln(stdout, item)
+	}
+}
+
+// spokenMove Get a move the way it is said (ex "Knight takes f three, check").
+// The move must be legal in the position.
+func spokenMove(position *game.Position, move game.Move) string {
+	language := messages.Language()
+	san := position.LocalSAN(move, language)
+
+	var words []string
+	switch {
+	case strings.HasPrefix(san, "O-O-O"):
+		words = append(words, messages.Text("castles queenside"))
+	case strings.HasPrefix(san, "O-O"):
+		words = append(words, messages.Text("castles kingside"))
+	default:
+		if move.Piece != game.Pawn {
+			words = append(words, language.PieceName(move.Piece))
+
+			// the part of the origin square telling pieces of the same type apart ("Nbd2")
+			origin := strings.TrimPrefix(strings.TrimRight(san, "+#"), language.Letter(move.Piece))
+			origin = strings.TrimSuffix(strings.TrimSuffix(origin, move.To.Notation()), "x")
+			if origin != "" {
+				words = append(words, game.SpokenCoordinates(origin))
+			}
+		} else if strings.Contains(san, "x") {
+			words = append(words, move.From.Notation()[:1])
+		}
+
+		if strings.Contains(san, "x") {
+			words = append(words, messages.Text("takes"))
+		}
+		words = append(words, move.To.SpokenNotation())
+
+		if move.Promotion != "" {
+			words = append(words, messages.Sprintf("promotes to %s", language.PieceName(move.Promotion)))
+		}
+	}
+
+	text := strings.Join(words, " ")
+	switch {
+	case strings.HasSuffix(san, "#"):
+		text += ", " + messages.Text("checkmate")
+	case strings.HasSuffix(san, "+"):
+		text += ", " + messages.Text("check")
+	}
+	return text
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+	"github.com/AngelVI13/blind_chess/pkg/store"
+)
+
+func runDaily(args []string) {
+	flags := flag.NewFlagSet("daily", flag.ExitOnError)
+	dataDir := dataDirFlag(flags)
+	name := playerNameFlag(flags)
+	d := displayFlags(flags)
+	languageFlag(flags)
+	flags.Parse(args)
+
+	config := game.DailyConfig(time.Now())
+	config.Language = messages.Language()
+
+	log, err := store.LoadDailyLog(*dataDir)
+	if err != nil {
+		messages.Fprintf(stdout, "Failed to load daily attempts: %v\n", err)
+		exit(1)
+	}
+
+	if result, finished, found := log.Attempt(config.Daily); found {
+		messages.Fprintf(stdout, "You already played the daily challenge for %s\n", config.Daily)
+		if finished {
+			fmt.Fprintln(stdout, result.Share())
+		}
+		return
+	}
+
+	g, err := game.NewWithConfig(config)
+	if err != nil {
+		messages.Fprintf(stdout, "Can't start the game: %v\n", err)
+		exit(1)
+	}
+
+	if err := log.Begin(config.Daily); err != nil {
+		messages.Fprintf(stdout, "Failed to record daily attempt: %v\n", err)
+		exit(1)
+	}
+	play(g, d)
+
+	result := game.NewDailyResult(g)
+	if err := log.Finish(result); err != nil {
+		messages.Fprintf(stdout, "Failed to record daily result: %v\n", err)
+	}
+
+	fmt.Fprintln(stdout, result.Share())
+	saveScore(*dataDir, *name, g)
+}
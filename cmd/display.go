@@ -0,0 +1,106 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+// display Settings for drawing boards in the terminal.
+type display struct {
+	unicode bool
+	colored bool
+	black   bool
+	// debug draws the board before every question
+	debug bool
+	// grouping how pieces are grouped when the position is described in words
+	grouping string
+	// shortNames describes pieces by their letters (ex "Kg1")
+	shortNames bool
+	// figurines writes moves in figurine notation (ex "♘f3")
+	figurines bool
+}
+
+// displayFlags Adds the board drawing flags to flags.
+func displayFlags(flags *flag.FlagSet) *display {
+	d := &display{}
+
+	flags.BoolVar(&d.unicode, "unicode", false, "draw pieces as unicode figurines")
+	flags.BoolVar(&d.colored, "color", false, "draw square colours")
+	flags.BoolVar(&d.black, "black", false, "view the board from black's side")
+	flags.BoolVar(&d.debug, "debug", false, "show the board before every question")
+	flags.StringVar(&d.grouping, "describe", "side", "group pieces of described positions by side or type")
+	flags.BoolVar(&d.shortNames, "short-names", false, "describe pieces by their letters (ex Kg1)")
+	flags.BoolVar(&d.figurines, "figurines", false, "write moves with figurines (ex ♘f3)")
+	accessibleFlags(flags)
+
+	return d
+}
+
+func (d *display) options() game.RenderOptions {
+	options := game.DefaultRenderOptions()
+
+	if d.unicode {
+		options.Style = game.UnicodeStyle
+	}
+	options.Colored = d.colored
+
+	if d.black {
+		options.Orientation = game.Black
+	}
+	options.Language = messages.Language()
+	return options
+}
+
+// printBoard Draws the board, or describes it line by line in accessible mode.
+func (d *display) printBoard(board *game.Board) {
+	if accessible {
+		d.printDescription(board, false)
+		return
+	}
+	fmt.Fprint(stdout, board.Render(d.options()))
+}
+
+// printDescription Prints the position of the board in words, one line per group in accessible mode.
+func (d *display) printDescription(board *game.Board, labels bool) {
+	if accessible {
+		for _, line := range board.DescribeLines(d.describeOptions(labels)) {
+			fmt.Fprintln(stdout, line)
+		}
+		return
+	}
+	fmt.Fprintln(stdout, d.describe(board, labels))
+}
+
+// describe Get the position of the board in words. labels names pieces
+// the way questions refer to them (ex "Knight 2").
+func (d *display) describe(board *game.Board, labels bool) string {
+	return board.Describe(d.describeOptions(labels))
+}
+
+func (d *display) describeOptions(labels bool) game.DescribeOptions {
+	options := game.DefaultDescribeOptions()
+
+	if d.grouping == "type" {
+		options.Grouping = game.GroupByType
+	}
+	options.ShortNames = d.shortNames
+	options.Labels = labels
+	options.Language = messages.Language()
+	options.Spoken = accessible
+
+	return options
+}
+
+// san Get the move in algebraic notation with figurines or the letters of the chosen
+// language, or the way it is said in accessible mode.
+func (d *display) san(position *game.Position, move game.Move) string {
+	if accessible {
+		return spokenMove(position, move)
+	}
+	if d.figurines {
+		return position.FigurineSAN(move)
+	}
+	return position.LocalSAN(move, messages.Language())
+}
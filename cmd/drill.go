@@ -0,0 +1,95 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/AngelVI13/blind_chess/pkg/openings"
+)
+
+const (
+	// defaultShownMoves half-moves of a line played for the player before reciting
+	defaultShownMoves = 4
+	// defaultDrillQuestions questions asked about the position at the end of a line
+	defaultDrillQuestions = 3
+)
+
+func runDrill(args []string) {
+	flags := flag.NewFlagSet("drill", flag.ExitOnError)
+	path := flags.String("file", "", "file with opening lines (PGN or one \"Name: 1. e4 e5\" line per line)")
+	shown := flags.Int("shown", defaultShownMoves, "half-moves played for you before you continue the line")
+	questions := flags.Int("questions", defaultDrillQuestions, "questions about the position at the end of each line")
+	shuffle := flags.Bool("shuffle", false, "drill the lines in random order")
+	seed := flags.Int64("seed", 0, "seed for choosing questions (0 picks a random one)")
+	d := displayFlags(flags)
+	languageFlag(flags)
+	flags.Parse(args)
+
+	if *path == "" {
+		messages.Fprintln(stdout, "Choose a file of opening lines with -file")
+		exit(2)
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(*seed))
+
+	lines, err := openings.Load(*path)
+	if err != nil {
+		messages.Fprintf(stdout, "Failed to load %s: %v\n", *path, err)
+		exit(1)
+	}
+	if *shuffle {
+		rng.Shuffle(len(lines), func(i, j int) { lines[i], lines[j] = lines[j], lines[i] })
+	}
+
+	mistakes, correct, asked := 0, 0, 0
+
+	for _, line := range lines {
+		fmt.Fprintf(stdout, "\n%s\n", line.Name)
+
+		drill, err := openings.NewDrill(line, *shown)
+		if err != nil {
+			messages.Fprintf(stdout, "Skipping the line: %v\n", err)
+			continue
+		}
+		if shownMoves := drill.Shown(messages.Language()); len(shownMoves) > 0 {
+			printMoveList(shownMoves)
+		}
+
+		for !drill.Done() {
+			fmt.Fprintf(stdout, "%s ", moveNumber(drill.Ply()))
+			text := readText()
+
+			ok, expected, err := drill.Answer(text, messages.Language())
+			switch {
+			case err != nil:
+				messages.Fprintf(stdout, "Can't play %q: %v\n", text, err)
+			case ok:
+				messages.Fprintln(stdout, "Correct")
+			default:
+				messages.Fprintf(stdout, "Wrong, the line continues %s\n", expected)
+			}
+		}
+		mistakes += drill.Mistakes
+
+		board := drill.Position().Board()
+		for i := 0; i < *questions; i++ {
+			asked++
+			if askPositionQuestion(board, rng, d) {
+				correct++
+			}
+		}
+	}
+
+	messages.Fprintf(
+		stdout,
+		"\n%d lines drilled with %d wrong moves, %d of %d questions answered correctly\n",
+		len(lines),
+		mistakes,
+		correct,
+		asked,
+	)
+}
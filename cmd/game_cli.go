@@ -1,115 +1,491 @@
 package main
 
 import (
+	"errors"
+	"flag"
 	"fmt"
-	"math/rand"
+	"io"
+	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/AngelVI13/blind_chess/pkg/game"
+	"github.com/AngelVI13/blind_chess/pkg/store"
 )
 
 func clearScreen() {
-	fmt.Print("\033[H\033[2J")
+	if accessible {
+		return
+	}
+	fmt.Fprint(stdout, "\033[H\033[2J")
 }
 
 func Score(g *game.Game) string {
-	return fmt.Sprintf(
-		"Level %d Score %d/%d Total %d\n",
+	score := messages.Sprintf(
+		"Level %d Score %d/%d Total %d Points %d",
 		g.Level(),
 		g.Score%game.QuestionsPerLevel,
 		game.QuestionsPerLevel,
 		g.Score,
+		g.Points(),
 	)
-}
 
-func readAnswer(numAvailableOptions int) (int, error) {
-	var answer string
+	if g.Config().Mode == game.MultipleLives {
+		score += messages.Sprintf(" Lives %d", g.Lives())
+	}
+	return score + "\n"
+}
 
-	_, err := fmt.Scanln(&answer)
+// dataDirFlag Adds the -data flag (where scores and daily attempts are kept) to flags.
+func dataDirFlag(flags *flag.FlagSet) *string {
+	dir, err := store.DefaultDir()
 	if err != nil {
-		return -1, err
+		dir = "."
+	}
+
+	return flags.String("data", dir, "directory to keep game data in")
+}
+
+func parseConfig(flags *flag.FlagSet, args []string) game.Config {
+	config := game.DefaultConfig()
+
+	modeName := flags.String("mode", config.Mode.String(), "game mode: sudden, lives or practice")
+	flags.IntVar(&config.Lives, "lives", config.Lives, "number of lives in 'lives' mode")
+	flags.BoolVar(&config.Endless, "endless", config.Endless, "keep playing after the last level")
+	flags.DurationVar(&config.TimeLimit, "time", config.TimeLimit, "time limit per question (ex 20s), 0 for no limit")
+	flags.IntVar(&config.SilentMoves, "silent", config.SilentMoves, "number of extra silent moves between questions")
+	flags.Int64Var(&config.Seed, "seed", config.Seed, "seed for positions and questions, 0 for random")
+	flags.DurationVar(&config.Memorize, "memorize", config.Memorize, "time to memorise the starting position, 0 to wait for Enter")
+	flags.IntVar(&config.PeekCost, "peek-cost", config.PeekCost, "points deducted for peeking at the board")
+	answers := flags.String("answer", "piece", "how to answer: piece (pick from a list) or move (ex. Ng1-f3)")
+	languageFlag(flags)
+	fairyFlag(flags, &config)
+	flags.IntVar(&config.AnnouncedMoves, "announce", config.AnnouncedMoves, "number of moves announced between questions, 0 for silent jumps")
+	flags.IntVar(&config.TrainingWheels, "wheels", config.TrainingWheels, "show the board again after this many questions (grows every time), 0 for never")
+	flags.Parse(args)
+
+	mode, ok := game.ParseMode(*modeName)
+	if !ok {
+		messages.Fprintf(stdout, "Unknown game mode %s\n", *modeName)
+		flags.Usage()
+		exit(2)
+	}
+	config.Mode = mode
+
+	switch *answers {
+	case "piece":
+		config.Answers = game.PieceAnswers
+	case "move":
+		config.Answers = game.MoveAnswers
+	default:
+		messages.Fprintf(stdout, "Unknown answer type %s\n", *answers)
+		flags.Usage()
+		exit(2)
+	}
+	config.Language = messages.Language()
+
+	return config
+}
+
+func printWrongAnswer(question game.Question, outcome game.Outcome) {
+	if outcome.TimedOut {
+		messages.Fprintf(stdout, "Too slow! ")
+	} else {
+		messages.Fprintf(stdout, "Wrong! ")
+	}
+
+	name := messages.Language().Translate(outcome.Label)
+	if question.Kind == game.LocateQuestion {
+		messages.Fprintf(stdout, "The %s is on %s\n", name, notation(outcome.From))
+		return
+	}
+
+	messages.Fprintf(
+		stdout,
+		"The %s on %s was the only piece that could go to %s\n",
+		name,
+		notation(outcome.From),
+		notation(outcome.Square),
+	)
+	printExplanations(outcome)
+}
+
+func printSilentSquares(g *game.Game) {
+	squares := g.SilentSquares()
+	if len(squares) == 0 {
+		return
+	}
+
+	notations := make([]string, 0, len(squares))
+	for _, sq := range squares {
+		notations = append(notations, notation(sq))
+	}
+	printList("Pieces also moved silently to", notations)
+}
+
+// fairyFlag Adds the -fairy flag (fairy pieces added after the usual levels) to flags.
+func fairyFlag(flags *flag.FlagSet, config *game.Config) {
+	var names []string
+	for _, definition := range game.FairyPieces {
+		names = append(names, fmt.Sprintf("%s (%s)", definition.Type, definition.Betza))
+	}
+
+	flags.Func(
+		"fairy",
+		"comma separated fairy pieces to add after the usual levels: "+strings.Join(names, ", ")+
+			" or your own as Name:Letter:Betza (ex Zebra:Z:Z)",
+		func(text string) error {
+			for _, item := range strings.Split(text, ",") {
+				definition, err := game.ParsePieceDefinition(item)
+				if err != nil {
+					return err
+				}
+
+				config.Pieces = append(config.Pieces, definition)
+			}
+			return nil
+		},
+	)
+}
+
+// exitOnEOF Stops the program once there is no more input to read.
+func exitOnEOF(err error) {
+	if errors.Is(err, io.EOF) {
+		exit(0)
+	}
+}
+
+const (
+	// hintKey answer that asks for a hint instead of answering
+	hintKey = "h"
+	// peekKey answer that shows the board for a moment instead of answering
+	peekKey = "p"
+)
+
+func printAnnouncedMoves(g *game.Game) {
+	moves := g.AnnouncedMoves()
+	if len(moves) == 0 {
+		return
 	}
 
+	notations := make([]string, 0, len(moves))
+	for _, move := range moves {
+		if accessible {
+			notations = append(notations, moveText(move))
+		} else {
+			notations = append(notations, move.LocalLongAlgebraic(g.Language()))
+		}
+	}
+	printList("Moves", notations)
+}
+
+func parseAnswer(answer string, numAvailableOptions int) (int, error) {
 	answerChoice, err := strconv.Atoi(answer)
 	if err != nil {
-		return -1, fmt.Errorf(
+		return -1, errors.New(messages.Text(
 			"Answer should be an number corresponding to the piece from available options",
-		)
+		))
 	}
 
 	// NOTE: answer choice here is an index
 	if answerChoice < 0 || answerChoice > numAvailableOptions-1 {
-		return -1, fmt.Errorf(
+		return -1, errors.New(messages.Sprintf(
 			"Answer should be a number between 0 and %d (inclusive)", numAvailableOptions-1,
-		)
+		))
 	}
 
 	return answerChoice, nil
 }
 
-func printQuestion(game *game.Game, questionPiece game.Piece, questionSquare *game.Square) {
-	question := fmt.Sprintf("Which piece can go to %s", questionSquare.Notation())
-	possibleAnswers := ""
-	pieces := game.PieceTypes()
-	for idx, p := range pieces {
-		possibleAnswers += fmt.Sprintf("%d. %s", idx, p)
+func printHint(g *game.Game) {
+	hint, err := g.Hint()
+	if err != nil {
+		fmt.Fprintln(stdout, err.Error())
+		return
+	}
 
-		if idx < len(pieces)-1 {
-			possibleAnswers += ", "
-		}
+	messages.Fprintf(stdout, "Hint (-%d points): ", hint.Cost)
+	switch hint.Kind {
+	case game.ColorHint:
+		color := strings.ToLower(messages.Language().ColorName(hint.Square.Color()))
+		messages.Fprintf(stdout, "the piece stands on a %s square\n", color)
+	case game.FileHint:
+		messages.Fprintf(stdout, "the piece stands on the %s-file\n", hint.Square.Notation()[:1])
+	case game.LocationHint:
+		messages.Fprintf(stdout, "the piece stands on %s\n", notation(hint.Square))
 	}
-	question = fmt.Sprintf("%s (%s):", question, possibleAnswers)
-	fmt.Println(question)
 }
 
-func main() {
-	rand.Seed(time.Now().UnixNano())
-
-	g := game.New()
+// peek Shows the board for a short time at the cost of some points.
+func peek(g *game.Game, d *display) {
+	duration, err := g.Peek()
+	if err != nil {
+		fmt.Fprintln(stdout, err.Error())
+		return
+	}
 
-	g.SetupPreGame()
+	clearScreen()
+	messages.Fprintf(stdout, "Peek (-%d points)\n", g.Config().PeekCost)
+	d.printBoard(g.Board())
+	time.Sleep(duration)
+	clearScreen()
+}
 
+// memorize Shows the starting position until the player is ready.
+func memorize(g *game.Game, d *display) {
 	clearScreen()
-	fmt.Println("Starting position")
-	for _, p := range g.BoardPieces() {
-		fmt.Printf("%s at %s\n", p.Type(), p.Square().Notation())
+	messages.Fprintln(stdout, "Starting position")
+	if !accessible {
+		d.printBoard(g.Board())
 	}
+	d.printDescription(g.Board(), true)
 
-	fmt.Println("Game starts in 5 seconds")
-	time.Sleep(5 * time.Second)
+	if memorizeTime := g.Config().Memorize; memorizeTime > 0 {
+		messages.Fprintf(stdout, "Game starts in %s\n", memorizeTime)
+		time.Sleep(memorizeTime)
+	} else {
+		messages.Fprintf(stdout, "Press Enter when ready")
+		readLine()
+	}
 	clearScreen()
+}
+
+func printQuestion(g *game.Game) {
+	if limit := g.TimeLimit(); limit > 0 {
+		messages.Fprintf(stdout, "(%s to answer) ", limit)
+	}
+
+	question := g.Question()
+	if question.Kind == game.LocateQuestion {
+		messages.Fprintf(
+			stdout,
+			"Where is the %s (ex. e4, %s for a hint, %s to peek):\n",
+			label(g.Board(), question.Piece),
+			hintKey,
+			peekKey,
+		)
+		return
+	}
+
+	if g.Config().Answers == game.MoveAnswers {
+		messages.Fprintf(
+			stdout,
+			"Which piece can go to %s? Enter the move with its origin (ex. Ng1-f3, %s for a hint, %s to peek):\n",
+			notation(question.Square),
+			hintKey,
+			peekKey,
+		)
+		return
+	}
+
+	printReachQuestion(g, question.Square)
+}
+
+func printReachQuestion(game *game.Game, questionSquare *game.Square) {
+	if accessible {
+		messages.Fprintf(stdout, "Which piece can go to %s?\n", notation(questionSquare))
+		for idx, p := range game.BoardPieces() {
+			fmt.Fprintf(stdout, "%d. %s\n", idx, label(game.Board(), p))
+		}
+		messages.Fprintf(stdout, "%s for a hint, %s to peek\n", hintKey, peekKey)
+		return
+	}
+
+	question := messages.Sprintf("Which piece can go to %s", questionSquare.Notation())
+	possibleAnswers := ""
+	pieces := game.BoardPieces()
+	for idx, p := range pieces {
+		possibleAnswers += fmt.Sprintf("%d. %s", idx, label(game.Board(), p))
+
+		if idx < len(pieces)-1 {
+			possibleAnswers += ", "
+		}
+	}
+	question = messages.Sprintf(
+		"%s (%s, %s for a hint, %s to peek):",
+		question,
+		possibleAnswers,
+		hintKey,
+		peekKey,
+	)
+	fmt.Fprintln(stdout, question)
+}
+
+// play Plays the game until it is over.
+func play(g *game.Game, d *display) {
+	if err := g.SetupPreGame(); err != nil {
+		fmt.Fprintln(stdout, err.Error())
+		return
+	}
+	memorize(g, d)
 
 	g.StartGame()
 
 	for {
-		questionPiece, questionSquare := g.QuestionPieceAndSquare()
+		question := g.Question()
 
-		printQuestion(g, questionPiece, questionSquare)
-		answer, err := readAnswer(len(g.BoardPieces()))
-		if err != nil {
-			fmt.Println(err.Error())
+		if d.debug || g.ShowBoard() {
+			d.printBoard(g.Board())
+		}
+		printQuestion(g)
+
+		input := readText()
+		if input == "" {
 			continue
 		}
 
-		answerPiece := g.BoardPieces()[answer]
-		if answerPiece.Type() != questionPiece.Type() {
-			fmt.Printf("Game over! (correct piece was %s)\n", questionPiece.Type())
-			fmt.Printf("%s", Score(g))
+		if input == hintKey {
+			printHint(g)
+			continue
+		}
+
+		if input == peekKey {
+			peek(g, d)
+			continue
+		}
+
+		var outcome game.Outcome
+		if question.Kind == game.LocateQuestion {
+			square, err := game.ParseSquare(input)
+			if err != nil {
+				fmt.Fprintln(stdout, err.Error())
+				continue
+			}
+
+			outcome, err = g.AnswerSquare(square)
+			if err != nil {
+				fmt.Fprintln(stdout, err.Error())
+				break
+			}
+		} else if g.Config().Answers == game.MoveAnswers {
+			var err error
+			outcome, err = g.AnswerMove(input)
+			if errors.Is(err, game.ErrInvalidSAN) || errors.Is(err, game.ErrInvalidAnswer) {
+				// mistyped moves don't count as answers
+				fmt.Fprintln(stdout, err.Error())
+				continue
+			}
+
+			if err != nil {
+				fmt.Fprintln(stdout, err.Error())
+				break
+			}
+		} else {
+			pieces := g.BoardPieces()
+			answer, err := parseAnswer(input, len(pieces))
+			if err != nil {
+				fmt.Fprintln(stdout, err.Error())
+				continue
+			}
+
+			outcome, err = g.AnswerPiece(pieces[answer].ID())
+			if err != nil {
+				fmt.Fprintln(stdout, err.Error())
+				break
+			}
+		}
+
+		if outcome.GameOver {
+			printWrongAnswer(question, outcome)
+			messages.Fprintf(stdout, "Game over! %s", Score(g))
+			review(g, d)
 			break
 		}
 
+		if !outcome.Correct {
+			// keep the explanation on screen, the player needs it to follow the position
+			printWrongAnswer(question, outcome)
+			fmt.Fprintf(stdout, "%s", Score(g))
+			printAnnouncedMoves(g)
+			printSilentSquares(g)
+			continue
+		}
+
 		clearScreen()
 
-		levelUp := g.SetNextPosition()
-		fmt.Printf("Success! %s", Score(g))
+		if outcome.Win {
+			messages.Fprintf(stdout, "You win! %s", Score(g))
+			break
+		}
+
+		messages.Fprintf(stdout, "Success! %s", Score(g))
 
-		if levelUp {
-			fmt.Printf(
+		if outcome.LevelUp && g.LevelUpPiece != nil {
+			messages.Fprintf(
+				stdout,
 				"Level up! A new %s was added to %s\n",
-				g.LevelUpPiece.Type(),
-				g.LevelUpPiece.Square().Notation(),
+				label(g.Board(), g.LevelUpPiece),
+				notation(g.LevelUpPiece.Square()),
 			)
+		} else if outcome.LevelUp {
+			messages.Fprintf(stdout, "Level up! The board is full, difficulty raised to %d\n", g.Difficulty())
 		}
+
+		printAnnouncedMoves(g)
+		printSilentSquares(g)
+	}
+}
+
+func runClassic(args []string) {
+	flags := flag.NewFlagSet("classic", flag.ExitOnError)
+	dataDir := dataDirFlag(flags)
+	name := playerNameFlag(flags)
+	d := displayFlags(flags)
+	config := parseConfig(flags, args)
+
+	g, err := game.NewWithConfig(config)
+	if err != nil {
+		messages.Fprintf(stdout, "Can't start the game: %v\n", err)
+		exit(2)
+	}
+	play(g, d)
+
+	saveScore(*dataDir, *name, g)
+}
+
+const usage = `Usage: blind_chess [command] [flags]
+
+Commands:
+  classic   play the classic game (default)
+  daily     play today's daily challenge (one attempt per day)
+  play      play a whole blindfold game against the engine
+  replay    replay a game from a PGN file blindfold and answer questions
+  drill     recite opening lines from a file and answer questions
+  puzzle    solve tactics puzzles from a Lichess CSV file blindfold
+  leaderboard
+            show the high scores
+
+Run 'blind_chess <command> -h' for the flags of a command.
+`
+
+func main() {
+	defer closeSpeech()
+
+	command := "classic"
+	args := os.Args[1:]
+
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		command, args = args[0], args[1:]
+	}
+
+	switch command {
+	case "classic":
+		runClassic(args)
+	case "daily":
+		runDaily(args)
+	case "leaderboard":
+		runLeaderboard(args)
+	case "play":
+		runPlay(args)
+	case "replay":
+		runReplay(args)
+	case "drill":
+		runDrill(args)
+	case "puzzle":
+		runPuzzle(args)
+	default:
+		fmt.Fprint(stdout, usage)
+		exit(2)
 	}
 }
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+	"github.com/AngelVI13/blind_chess/pkg/store"
+)
+
+// playerNameFlag Adds the -name flag (name used on the leaderboard) to flags.
+func playerNameFlag(flags *flag.FlagSet) *string {
+	name := os.Getenv("USER")
+	if name == "" {
+		name = "player"
+	}
+
+	return flags.String("name", name, "player name for the leaderboard")
+}
+
+// saveScore Adds the score of a finished game to the leaderboard.
+func saveScore(dataDir, name string, g *game.Game) {
+	leaderboard, err := store.LoadLeaderboard(dataDir)
+	if err != nil {
+		messages.Fprintf(stdout, "Failed to load leaderboard: %v\n", err)
+		return
+	}
+
+	rank, err := leaderboard.Add(g.Config().Key(), store.NewEntry(name, g))
+	if err != nil {
+		messages.Fprintf(stdout, "Failed to save score: %v\n", err)
+		return
+	}
+
+	if rank > 0 {
+		messages.Fprintf(stdout, "New high score! Rank %d on the %s leaderboard\n", rank, g.Config().Key())
+	}
+}
+
+func printTable(leaderboard *store.Leaderboard, table string) {
+	fmt.Fprintf(stdout, "== %s ==\n", table)
+	fmt.Fprintf(
+		stdout,
+		"%4s %-16s %6s %5s %9s %20s %10s\n",
+		"#",
+		messages.Text("Name"),
+		messages.Text("Score"),
+		messages.Text("Level"),
+		messages.Text("Time"),
+		messages.Text("Seed"),
+		messages.Text("Date"),
+	)
+
+	for idx, entry := range leaderboard.Table(table) {
+		tampered := ""
+		if !leaderboard.Verify(table, entry) {
+			tampered = messages.Text(" (tampered)")
+		}
+
+		fmt.Fprintf(
+			stdout,
+			"%4d %-16s %6d %5d %9s %20d %10s%s\n",
+			idx+1,
+			entry.Name,
+			entry.Score,
+			entry.Level,
+			entry.Duration,
+			entry.Seed,
+			entry.Date.Format(game.DailyDateFormat),
+			tampered,
+		)
+	}
+	fmt.Fprintln(stdout)
+}
+
+func runLeaderboard(args []string) {
+	flags := flag.NewFlagSet("leaderboard", flag.ExitOnError)
+	dataDir := dataDirFlag(flags)
+	table := flags.String("table", "", "show only this table (ex sudden, lives-3, endless-sudden)")
+	languageFlag(flags)
+	flags.Parse(args)
+
+	leaderboard, err := store.LoadLeaderboard(*dataDir)
+	if err != nil {
+		messages.Fprintf(stdout, "Failed to load leaderboard: %v\n", err)
+		exit(1)
+	}
+
+	if *table != "" {
+		printTable(leaderboard, *table)
+		return
+	}
+
+	names := leaderboard.TableNames()
+	if len(names) == 0 {
+		messages.Fprintln(stdout, "No scores yet")
+		return
+	}
+
+	for _, name := range names {
+		printTable(leaderboard, name)
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"strings"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+	"github.com/AngelVI13/blind_chess/pkg/locale"
+)
+
+// messages texts shown to the player in the language chosen with -lang.
+var messages = locale.Default()
+
+// languageFlag Adds the -lang flag (language of texts, piece names and notation) to flags.
+func languageFlag(flags *flag.FlagSet) {
+	flags.Func(
+		"lang",
+		"language of texts and piece letters: "+strings.Join(locale.Codes(), ", "),
+		func(code string) error {
+			catalogue, err := locale.New(code)
+			if err != nil {
+				return err
+			}
+
+			messages = catalogue
+			return nil
+		},
+	)
+}
+
+// label Get the label of the piece (see Board.Label) in the chosen language.
+func label(board *game.Board, piece game.Piece) string {
+	return messages.Language().Translate(board.Label(piece))
+}
+
+// nounCase Get the name of a piece as it is written inside a sentence (ex
+// "knight", German nouns keep their capital letter).
+func nounCase(name string) string {
+	if messages.Language().Code == game.English.Code {
+		return strings.ToLower(name)
+	}
+	return name
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AngelVI13/blind_chess/pkg/engine"
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+const (
+	// displayKey command that shows the board (costs penalty points)
+	displayKey = "display"
+	// describeKey command that describes the position in words
+	describeKey = "describe"
+	// defaultDisplayPenalty penalty points for showing the board once
+	defaultDisplayPenalty = 10
+)
+
+// whereQuery questions like "where is my queen?" or "where are the black knights".
+// Translations keep the groups of the sides (my, white, black, enemy) and the piece name.
+const whereQuery = `^where (?:is|are) (?:(?P<my>my)|(?P<white>the white)|(?P<black>the black)|(?P<enemy>the enemy)|the)?\s*(?P<piece>\pL+)\??$`
+
+var stdin = bufio.NewReader(os.Stdin)
+
+// readText Reads a whole line of input (commands in play mode have several words).
+func readText() string {
+	line, err := stdin.ReadString('\n')
+	if err != nil && line == "" {
+		exitOnEOF(err)
+	}
+
+	if speech != nil {
+		speech.endLine()
+	}
+	return strings.TrimSpace(line)
+}
+
+func opponent(color game.Color) game.Color {
+	if color == game.White {
+		return game.Black
+	}
+	return game.White
+}
+
+// answerWhere Answers a "where is ..." question, pieces without a side are the
+// player's own (ex "where is the queen"). Returns false if the text is not such a question.
+func answerWhere(position *game.Position, player game.Color, text string) (string, bool) {
+	query := regexp.MustCompile(messages.Text(whereQuery))
+	match := query.FindStringSubmatch(strings.ToLower(strings.TrimSpace(text)))
+	if match == nil {
+		return "", false
+	}
+
+	groups := map[string]string{}
+	for i, name := range query.SubexpNames() {
+		groups[name] = match[i]
+	}
+
+	language := messages.Language()
+	pieceType, found := language.PieceTypeByName(groups["piece"])
+	if !found {
+		return messages.Sprintf("I don't know the piece %q", groups["piece"]), true
+	}
+
+	color, owner := player, "Your %s"
+	switch {
+	case groups["white"] != "":
+		color, owner = game.White, "The white %s"
+	case groups["black"] != "":
+		color, owner = game.Black, "The black %s"
+	case groups["enemy"] != "":
+		color, owner = opponent(player), "The enemy %s"
+	}
+
+	squares := position.Find(pieceType, color)
+
+	notations := make([]string, 0, len(squares))
+	for _, square := range squares {
+		notations = append(notations, notation(square))
+	}
+
+	switch len(squares) {
+	case 0:
+		pieces := messages.Sprintf(owner, nounCase(language.PieceName(pieceType)))
+		return messages.Sprintf("%s is not on the board", pieces), true
+	case 1:
+		pieces := messages.Sprintf(owner, nounCase(language.PieceName(pieceType)))
+		return messages.Sprintf("%s is on %s", pieces, notations[0]), true
+	default:
+		pieces := messages.Sprintf(owner, nounCase(language.PluralName(pieceType)))
+		return messages.Sprintf("%s are on %s", pieces, strings.Join(notations, ", ")), true
+	}
+}
+
+// moveNumber Get the number prefix of a move of a game from the starting
+// position (ex "12." for White, "12..." for Black).
+func moveNumber(ply int) string {
+	return numberMove(1, game.White, ply)
+}
+
+// moveNumberFrom Get the number prefix of a move of a game from the start
+// position, which may be a later move or have Black to move (ex a FEN tag).
+func moveNumberFrom(start *game.Position, ply int) string {
+	return numberMove(start.FullmoveNumber(), start.SideToMove(), ply)
+}
+
+// numberMove Get the number prefix of the move ply half moves after the
+// first move (number) made by the side.
+func numberMove(number int, side game.Color, ply int) string {
+	if side == game.Black {
+		ply++
+	}
+
+	if ply%2 == 0 {
+		return fmt.Sprintf("%d.", number+ply/2)
+	}
+	return fmt.Sprintf("%d...", number+ply/2)
+}
+
+// result Get the text announcing the end of the game or "" if the game goes on.
+func result(position *game.Position, player game.Color) string {
+	switch {
+	case position.Checkmate() && position.SideToMove() == player:
+		return messages.Text("Checkmate, the engine wins")
+	case position.Checkmate():
+		return messages.Text("Checkmate, you win!")
+	case position.Stalemate():
+		return messages.Text("Stalemate, it's a draw")
+	}
+	return ""
+}
+
+const playHelp = `Enter moves in SAN (e4, Nf3, exd5, O-O, e8=Q).
+Other commands:
+  where is my queen?      where are the black pawns?
+  moves                   list the moves played so far
+  display                 show the board (costs penalty points)
+  resign                  give up the game
+`
+
+func runPlay(args []string) {
+	flags := flag.NewFlagSet("play", flag.ExitOnError)
+	side := flags.String("side", "white", "side you play: white or black")
+	strength := flags.Int(
+		"strength",
+		engine.DefaultStrength,
+		fmt.Sprintf("engine strength from %d to %d", engine.MinStrength, engine.MaxStrength),
+	)
+	seed := flags.Int64("seed", 0, "seed for the engine's choices (0 picks a random one)")
+	penalty := flags.Int("display-penalty", defaultDisplayPenalty, "penalty points for showing the board")
+	d := displayFlags(flags)
+	languageFlag(flags)
+	flags.Parse(args)
+
+	player := game.White
+	switch *side {
+	case "white":
+	case "black":
+		player = game.Black
+		d.black = true
+	default:
+		messages.Fprintf(stdout, "Unknown side %q, expected white or black\n", *side)
+		exit(2)
+	}
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	opponentEngine := engine.New(*strength, rand.New(rand.NewSource(*seed)))
+
+	position := game.NewPosition()
+	var moves []string
+	displays := 0
+
+	messages.Fprintf(
+		stdout,
+		"You play %s against the engine (strength %d)\n",
+		strings.ToLower(messages.Language().ColorName(player)),
+		opponentEngine.Strength(),
+	)
+	messages.Fprintf(stdout, playHelp)
+
+	for {
+		if text := result(position, player); text != "" {
+			fmt.Fprintln(stdout, text)
+			break
+		}
+
+		if position.SideToMove() != player {
+			move, _ := opponentEngine.BestMove(position)
+			san := d.san(position, move)
+			messages.Fprintf(stdout, "Engine plays %s %s\n", moveNumber(len(moves)), san)
+
+			position, _ = position.Play(move)
+			moves = append(moves, san)
+			continue
+		}
+
+		if position.InCheck() {
+			messages.Fprintln(stdout, "You are in check")
+		}
+		messages.Fprintf(stdout, "Your move %s ", moveNumber(len(moves)))
+		text := readText()
+
+		if answer, ok := answerWhere(position, player, text); ok {
+			fmt.Fprintln(stdout, answer)
+			continue
+		}
+
+		switch strings.ToLower(text) {
+		case "":
+			continue
+		case "help":
+			messages.Fprintf(stdout, playHelp)
+			continue
+		case "moves":
+			printMoveList(moves)
+			continue
+		case displayKey:
+			displays++
+			d.printBoard(position.Board())
+			messages.Fprintf(stdout, "Penalty: %d points\n", displays*(*penalty))
+			continue
+		case "resign":
+			messages.Fprintln(stdout, "You resigned, the engine wins")
+			printGameSummary(moves, displays, *penalty)
+			return
+		}
+
+		move, err := position.ParseLocalSAN(text, messages.Language())
+		if err != nil {
+			messages.Fprintf(stdout, "Can't play %q: %v\n", text, err)
+			continue
+		}
+
+		moves = append(moves, d.san(position, move))
+		position, _ = position.Play(move)
+	}
+
+	d.printBoard(position.Board())
+	printGameSummary(moves, displays, *penalty)
+}
+
+func printMoveList(moves []string) {
+	var list []string
+	for ply, san := range moves {
+		if ply%2 == 0 {
+			list = append(list, moveNumber(ply)+san)
+		} else {
+			list = append(list, san)
+		}
+	}
+	fmt.Fprintln(stdout, strings.Join(list, " "))
+}
+
+func printGameSummary(moves []string, displays, penalty int) {
+	printMoveList(moves)
+	messages.Fprintf(stdout, "Board shown %d times, penalty %d points\n", displays, displays*penalty)
+}
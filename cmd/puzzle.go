@@ -0,0 +1,141 @@
+package main
+
+import (
+	"flag"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+	"github.com/AngelVI13/blind_chess/pkg/puzzle"
+	"github.com/AngelVI13/blind_chess/pkg/store"
+)
+
+// defaultPuzzleCount puzzles in one session.
+const defaultPuzzleCount = 5
+
+func capitalize(text string) string {
+	if text == "" {
+		return text
+	}
+	return strings.ToUpper(text[:1]) + text[1:]
+}
+
+// solvePuzzle Lets the player find the moves of the puzzle. Returns true if it was solved.
+func solvePuzzle(attempt *puzzle.Attempt, d *display) bool {
+	for !attempt.Done() {
+		messages.Fprintf(stdout, "Your move: ")
+		text := readText()
+		switch text {
+		case displayKey:
+			d.printBoard(attempt.Position().Board())
+			continue
+		case describeKey:
+			d.printDescription(attempt.Position().Board(), false)
+			continue
+		}
+
+		correct, reply, err := attempt.Answer(text, messages.Language())
+		switch {
+		case err != nil:
+			messages.Fprintf(stdout, "Can't play %q: %v\n", text, err)
+		case !correct:
+			messages.Fprintf(stdout, "Wrong, the solution was %s\n", reply)
+		case reply != "":
+			messages.Fprintf(stdout, "Correct! The opponent replies %s\n", reply)
+		default:
+			messages.Fprintln(stdout, "Correct!")
+		}
+	}
+
+	return attempt.Solved()
+}
+
+func runPuzzle(args []string) {
+	flags := flag.NewFlagSet("puzzle", flag.ExitOnError)
+	path := flags.String("file", "", "Lichess puzzle CSV file")
+	minRating := flags.Int("min-rating", 0, "lowest puzzle rating")
+	maxRating := flags.Int("max-rating", 0, "highest puzzle rating (0 for no limit)")
+	theme := flags.String("theme", "", "only puzzles with the theme (ex fork, mateIn2)")
+	count := flags.Int("count", defaultPuzzleCount, "number of puzzles")
+	retry := flags.Bool("retry", false, "include puzzles you already solved")
+	seed := flags.Int64("seed", 0, "seed for choosing puzzles (0 picks a random one)")
+	dataDir := dataDirFlag(flags)
+	d := displayFlags(flags)
+	languageFlag(flags)
+	flags.Parse(args)
+
+	if *path == "" {
+		messages.Fprintln(stdout, "Choose a puzzle file with -file")
+		exit(2)
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	selected, err := puzzle.Load(*path, puzzle.Filter{MinRating: *minRating, MaxRating: *maxRating, Theme: *theme})
+	if err != nil {
+		messages.Fprintf(stdout, "Failed to load %s: %v\n", *path, err)
+		exit(1)
+	}
+
+	profile, err := store.LoadProfile(*dataDir)
+	if err != nil {
+		messages.Fprintf(stdout, "Failed to load your profile: %v\n", err)
+		exit(1)
+	}
+
+	if !*retry {
+		unsolved := selected[:0]
+		for _, p := range selected {
+			if !profile.Solved(p.ID) {
+				unsolved = append(unsolved, p)
+			}
+		}
+		selected = unsolved
+	}
+
+	if len(selected) == 0 {
+		messages.Fprintln(stdout, "No puzzles match your choice")
+		return
+	}
+
+	rng := rand.New(rand.NewSource(*seed))
+	rng.Shuffle(len(selected), func(i, j int) { selected[i], selected[j] = selected[j], selected[i] })
+	if len(selected) > *count {
+		selected = selected[:*count]
+	}
+
+	messages.Fprintf(
+		stdout,
+		"Enter the solution moves in SAN, type %s to see the board or %s to hear the position again\n",
+		displayKey,
+		describeKey,
+	)
+
+	solved := 0
+	for i, p := range selected {
+		attempt, err := p.Start()
+		if err != nil {
+			messages.Fprintf(stdout, "Skipping puzzle %s: %v\n", p.ID, err)
+			continue
+		}
+
+		messages.Fprintf(stdout, "\nPuzzle %d of %d (rating %d)\n", i+1, len(selected), p.Rating)
+		messages.Fprintf(stdout, "The last move was %s\n", attempt.SetupMove(messages.Language()))
+		d.printDescription(attempt.Position().Board(), false)
+		messages.Fprintf(stdout, "%s to move\n", capitalize(messages.Language().ColorName(attempt.Position().SideToMove())))
+
+		if solvePuzzle(attempt, d) {
+			solved++
+		}
+
+		date := time.Now().Format(game.DailyDateFormat)
+		if err := profile.RecordPuzzle(p.ID, attempt.Solved(), date); err != nil {
+			messages.Fprintf(stdout, "Failed to save your progress: %v\n", err)
+		}
+	}
+
+	attempted, total := profile.PuzzleStats()
+	messages.Fprintf(stdout, "\nSolved %d of %d puzzles (%d of %d puzzles solved so far)\n", solved, len(selected), total, attempted)
+}
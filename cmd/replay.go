@@ -0,0 +1,150 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+	"github.com/AngelVI13/blind_chess/pkg/pgn"
+)
+
+// defaultQuestionInterval number of half-moves between questions in a replay.
+const defaultQuestionInterval = 6
+
+// pieceName Get the name of a piece with its side (ex "black queen").
+func pieceName(piece game.Piece) string {
+	name := nounCase(messages.Language().PieceName(piece.Type()))
+
+	if piece.Color() == game.Black {
+		return messages.Sprintf("black %s", name)
+	}
+	return messages.Sprintf("white %s", name)
+}
+
+// askPositionQuestion Asks a question about the position and reads the answer
+// (a square). Returns true for a correct answer.
+func askPositionQuestion(board *game.Board, rng *rand.Rand, d *display) bool {
+	question, ok := game.NewReachQuestion(board, rng)
+	if rng.Intn(2) == 0 || !ok {
+		question, ok = game.NewUniqueLocateQuestion(board, rng)
+	}
+	if !ok {
+		return true
+	}
+
+	for {
+		if question.Kind == game.ReachQuestion {
+			messages.Fprintf(stdout, "Which piece can go to %s? (answer with its square) ", notation(question.Square))
+		} else {
+			messages.Fprintf(stdout, "Where is the %s? ", pieceName(question.Piece))
+		}
+
+		text := strings.ToLower(readText())
+		switch text {
+		case displayKey:
+			d.printBoard(board)
+			continue
+		case describeKey:
+			d.printDescription(board, false)
+			continue
+		}
+
+		square, err := game.ParseSquare(text)
+		if err != nil {
+			messages.Fprintf(stdout, "%q is not a square\n", text)
+			continue
+		}
+
+		correct := question.CheckSquare(board, square)
+		if question.Kind == game.ReachQuestion {
+			correct = question.CheckPiece(board.PieceAt(square))
+		}
+
+		if correct {
+			messages.Fprintln(stdout, "Correct!")
+		} else {
+			messages.Fprintf(
+				stdout,
+				"Wrong! The %s on %s\n",
+				pieceName(question.Piece),
+				notation(question.Piece.Square()),
+			)
+		}
+		return correct
+	}
+}
+
+// chooseGame Picks a game of a PGN file by its number (1 for the first game).
+func chooseGame(path string, number int) pgn.Game {
+	games, err := pgn.Load(path)
+	if err != nil {
+		messages.Fprintf(stdout, "Failed to load %s: %v\n", path, err)
+		exit(1)
+	}
+
+	if number < 1 || number > len(games) {
+		messages.Fprintf(stdout, "%s has %d games:\n", path, len(games))
+		for i, g := range games {
+			fmt.Fprintf(stdout, "  %d. %s\n", i+1, g.Title())
+		}
+		exit(2)
+	}
+
+	return games[number-1]
+}
+
+func runReplay(args []string) {
+	flags := flag.NewFlagSet("replay", flag.ExitOnError)
+	path := flags.String("file", "", "PGN file with the games")
+	number := flags.Int("game", 1, "number of the game in the file (0 lists the games)")
+	every := flags.Int("every", defaultQuestionInterval, "half-moves between questions")
+	seed := flags.Int64("seed", 0, "seed for choosing questions (0 picks a random one)")
+	d := displayFlags(flags)
+	languageFlag(flags)
+	flags.Parse(args)
+
+	if *path == "" {
+		messages.Fprintln(stdout, "Choose a PGN file with -file")
+		exit(2)
+	}
+	if *every < 1 {
+		*every = 1
+	}
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+	rng := rand.New(rand.NewSource(*seed))
+
+	chosen := chooseGame(*path, *number)
+	moves, positions, err := chosen.Positions()
+	if err != nil {
+		messages.Fprintf(stdout, "Failed to replay %s: %v\n", chosen.Title(), err)
+		exit(1)
+	}
+
+	fmt.Fprintln(stdout, chosen.Title())
+	messages.Fprintf(stdout, "Answer with squares, type %s to see the board or %s to hear the position\n", displayKey, describeKey)
+
+	start, _ := chosen.Start()
+	before := start
+	correct, asked := 0, 0
+
+	for ply, move := range moves {
+		fmt.Fprintf(stdout, "%s %s\n", moveNumberFrom(start, ply), d.san(before, move))
+		before = positions[ply]
+
+		if (ply+1)%*every != 0 && ply != len(moves)-1 {
+			continue
+		}
+
+		asked++
+		if askPositionQuestion(before.Board(), rng, d) {
+			correct++
+		}
+	}
+
+	messages.Fprintf(stdout, "Result %s. You answered %d of %d questions correctly\n", chosen.Result, correct, asked)
+}
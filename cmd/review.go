@@ -0,0 +1,80 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+func explanationText(e game.Explanation) string {
+	language := messages.Language()
+
+	if e.Blocker == "" {
+		return messages.Sprintf(
+			"your %s on %s cannot reach %s, it doesn't move that way",
+			language.Translate(e.Label),
+			notation(e.From),
+			notation(e.To),
+		)
+	}
+
+	return messages.Sprintf(
+		"your %s on %s cannot reach %s because the %s on %s blocks it",
+		language.Translate(e.Label),
+		notation(e.From),
+		notation(e.To),
+		language.PieceName(e.Blocker),
+		notation(e.BlockerSquare),
+	)
+}
+
+func printExplanations(outcome game.Outcome) {
+	for _, explanation := range outcome.Explanations {
+		fmt.Fprintf(stdout, "  - %s\n", explanationText(explanation))
+	}
+}
+
+// readLine Reads a line (empty lines are allowed).
+func readLine() string {
+	return readText()
+}
+
+// moveText Get a move of the game history in words (ex "Knight g1-f3").
+func moveText(move game.Move) string {
+	name := messages.Language().PieceName(move.Piece)
+	if move.From == nil {
+		return messages.Sprintf("%s added on %s", name, notation(move.To))
+	}
+	if accessible {
+		return messages.Sprintf("%s %s to %s", name, notation(move.From), notation(move.To))
+	}
+	return fmt.Sprintf("%s %s-%s", name, notation(move.From), notation(move.To))
+}
+
+// review Shows the final position and replays the game move by move.
+func review(g *game.Game, d *display) {
+	messages.Fprintln(stdout, "Final position")
+	d.printBoard(g.Board())
+
+	messages.Fprintf(stdout, "Press Enter to replay the game move by move (q to quit): ")
+	if readLine() == "q" {
+		return
+	}
+
+	replay := g.Replay()
+	for step := 1; ; step++ {
+		move, ok := replay.Next()
+		if !ok {
+			break
+		}
+
+		clearScreen()
+		messages.Fprintf(stdout, "Step %d/%d: %s\n", step, len(g.History()), moveText(move))
+		d.printBoard(replay.Board())
+
+		messages.Fprintf(stdout, "Enter for the next move (q to quit): ")
+		if readLine() == "q" {
+			return
+		}
+	}
+}
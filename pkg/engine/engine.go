@@ -0,0 +1,175 @@
+// Package engine A small chess engine to play blindfold games against.
+package engine
+
+import (
+	"math/rand"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+const (
+	MinStrength     = 1
+	MaxStrength     = 5
+	DefaultStrength = 3
+
+	// mateScore score of a position in which the side to move is mated
+	mateScore = 100000
+	infinity  = mateScore + 1
+
+	// quiescenceDepth how many captures in a row are searched after the search depth
+	quiescenceDepth = 4
+)
+
+// levels search depth and random noise (in centipawns) added to the score of
+// each root move by strength (weaker levels miss more and blunder more).
+var levels = map[int]struct {
+	depth int
+	noise int
+}{
+	1: {depth: 1, noise: 300},
+	2: {depth: 2, noise: 150},
+	3: {depth: 2, noise: 40},
+	4: {depth: 3, noise: 10},
+	5: {depth: 4, noise: 0},
+}
+
+// pieceValues material value of the pieces in centipawns.
+var pieceValues = map[game.PieceType]int{
+	game.Pawn:   100,
+	game.Knight: 320,
+	game.Bishop: 330,
+	game.Rook:   500,
+	game.Queen:  900,
+	game.King:   0,
+}
+
+// Engine Picks moves with an alpha-beta search and a simple evaluation.
+type Engine struct {
+	strength int
+	rng      *rand.Rand
+}
+
+// New Create an engine of the given strength (clamped to MinStrength..MaxStrength).
+// The random source makes weaker levels vary their moves.
+func New(strength int, rng *rand.Rand) *Engine {
+	if strength < MinStrength {
+		strength = MinStrength
+	}
+	if strength > MaxStrength {
+		strength = MaxStrength
+	}
+
+	return &Engine{strength: strength, rng: rng}
+}
+
+func (e *Engine) Strength() int {
+	return e.strength
+}
+
+// BestMove Get the move the engine plays in the position. Returns false if the
+// side to move has no legal moves.
+func (e *Engine) BestMove(position *game.Position) (game.Move, bool) {
+	level := levels[e.strength]
+	moves := ordered(position, position.LegalMoves())
+	if len(moves) == 0 {
+		return game.Move{}, false
+	}
+
+	best, bestScore := moves[0], -infinity
+	for _, move := range moves {
+		next, err := position.Play(move)
+		if err != nil {
+			continue
+		}
+
+		// moves that can't beat the best move even with the most favourable
+		// noise only need to be searched until they fall below it
+		alpha := bestScore - 2*level.noise - 1
+		if bestScore == -infinity {
+			alpha = -infinity
+		}
+
+		score := -e.search(next, level.depth-1, -infinity, -alpha, 1)
+		if level.noise > 0 {
+			score += e.rng.Intn(2*level.noise+1) - level.noise
+		}
+
+		if score > bestScore {
+			best, bestScore = move, score
+		}
+	}
+
+	return best, true
+}
+
+// search Negamax alpha-beta search. Returns the score from the point of view of
+// the side to move.
+func (e *Engine) search(position *game.Position, depth, alpha, beta, ply int) int {
+	moves := position.LegalMoves()
+	if len(moves) == 0 {
+		if position.InCheck() {
+			return -mateScore + ply // prefer quicker mates
+		}
+		return 0
+	}
+
+	if depth <= 0 {
+		return e.quiesce(position, alpha, beta, quiescenceDepth)
+	}
+
+	for _, move := range ordered(position, moves) {
+		next, err := position.Play(move)
+		if err != nil {
+			continue
+		}
+
+		score := -e.search(next, depth-1, -beta, -alpha, ply+1)
+		if score >= beta {
+			return beta
+		}
+		if score > alpha {
+			alpha = score
+		}
+	}
+
+	return alpha
+}
+
+// quiesce Keeps searching captures so the evaluation doesn't stop in the middle
+// of an exchange.
+func (e *Engine) quiesce(position *game.Position, alpha, beta, depth int) int {
+	standPat := Evaluate(position)
+	if standPat >= beta {
+		return beta
+	}
+	if standPat > alpha {
+		alpha = standPat
+	}
+	if depth == 0 {
+		return alpha
+	}
+
+	var captures []game.Move
+	for _, move := range position.LegalMoves() {
+		if _, _, capture := position.PieceAt(move.To); capture || move.Promotion != "" {
+			captures = append(captures, move)
+		}
+	}
+
+	for _, move := range ordered(position, captures) {
+		next, err := position.Play(move)
+		if err != nil {
+			continue
+		}
+
+		score := -e.quiesce(next, -beta, -alpha, depth-1)
+		if score >= beta {
+			return beta
+		}
+		if score > alpha {
+			alpha = score
+		}
+	}
+
+	return alpha
+}
@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+func TestEngineMateInOne(t *testing.T) {
+	// Qh5xf7 is mate (scholar's mate)
+	position, err := game.ParseFEN("r1bqkbnr/pppp1ppp/2n5/4p2Q/2B1P3/8/PPPP1PPP/RNB1K1NR w KQkq - 4 4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for strength := 3; strength <= MaxStrength; strength++ {
+		engine := New(strength, rand.New(rand.NewSource(1)))
+		move, ok := engine.BestMove(position)
+		if !ok {
+			t.Fatalf("expected a move")
+		}
+
+		if san := position.SAN(move); san != "Qxf7#" {
+			t.Errorf("strength %d: expected Qxf7# but got %s", strength, san)
+		}
+	}
+}
+
+func TestEngineCapturesHangingQueen(t *testing.T) {
+	position, err := game.ParseFEN("4k3/8/8/3q4/8/8/3R4/4K3 w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	engine := New(MaxStrength, rand.New(rand.NewSource(1)))
+	move, _ := engine.BestMove(position)
+	if san := position.SAN(move); san != "Rxd5" {
+		t.Errorf("expected Rxd5 but got %s", san)
+	}
+}
+
+func TestEngineNoMoves(t *testing.T) {
+	position, err := game.ParseFEN("7k/5Q2/6K1/8/8/8/8/8 b - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := New(DefaultStrength, rand.New(rand.NewSource(1))).BestMove(position); ok {
+		t.Errorf("expected no move in stalemate")
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	if score := Evaluate(game.NewPosition()); score != 0 {
+		t.Errorf("expected the starting position to be equal but got %d", score)
+	}
+
+	// White is a queen up, so Black (to move) is worse
+	position, _ := game.ParseFEN("4k3/8/8/8/8/8/8/3QK3 b - - 0 1")
+	if score := Evaluate(position); score > -800 {
+		t.Errorf("expected a losing score for Black but got %d", score)
+	}
+}
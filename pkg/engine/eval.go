@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"sort"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+const (
+	// centreBonus bonus per step closer to the centre for knights and bishops
+	centreBonus = 8
+	// pawnAdvanceBonus bonus per rank a pawn has advanced
+	pawnAdvanceBonus = 6
+)
+
+// Evaluate Score the position in centipawns from the point of view of the side to move.
+func Evaluate(position *game.Position) int {
+	score := 0
+
+	for pieceType, value := range pieceValues {
+		for _, color := range []game.Color{game.White, game.Black} {
+			side := 1
+			if color == game.Black {
+				side = -1
+			}
+
+			for _, square := range position.Find(pieceType, color) {
+				score += side * (value + placement(pieceType, color, square))
+			}
+		}
+	}
+
+	if position.SideToMove() == game.Black {
+		return -score
+	}
+	return score
+}
+
+// placement Bonus for where a piece stands.
+func placement(pieceType game.PieceType, color game.Color, square *game.Square) int {
+	file, rank := square.Index()%game.FileNum, square.Index()/game.FileNum
+
+	switch pieceType {
+	case game.Knight, game.Bishop:
+		return centreBonus * (3 - max(distance(file), distance(rank)))
+	case game.Pawn:
+		if color == game.Black {
+			return pawnAdvanceBonus * (game.RankNum - 2 - rank)
+		}
+		return pawnAdvanceBonus * (rank - 1)
+	}
+
+	return 0
+}
+
+// distance Get how many steps a file (or rank) is away from the centre files.
+func distance(line int) int {
+	if line < game.FileNum/2 {
+		return game.FileNum/2 - 1 - line
+	}
+	return line - game.FileNum/2
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// ordered Sort the moves so captures of valuable pieces by cheap pieces and
+// promotions are searched first (it makes alpha-beta cut off sooner).
+func ordered(position *game.Position, moves []game.Move) []game.Move {
+	priority := func(move game.Move) int {
+		value := pieceValues[move.Promotion]
+		if victim, _, capture := position.PieceAt(move.To); capture {
+			value += 10*pieceValues[victim] - pieceValues[move.Piece]
+		}
+		return value
+	}
+
+	sort.SliceStable(moves, func(i, j int) bool {
+		return priority(moves[i]) > priority(moves[j])
+	})
+	return moves
+}
@@ -0,0 +1,312 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// betzaAtoms leaps of the basic pieces of Betza notation (every leap works in
+// all directions, ex "N" jumps one file and two ranks in any direction).
+var betzaAtoms = map[rune]DirectionVec{
+	'W': {file: 1, rank: 0}, // Wazir
+	'F': {file: 1, rank: 1}, // Ferz
+	'D': {file: 2, rank: 0}, // Dabbaba
+	'N': {file: 2, rank: 1}, // Knight
+	'A': {file: 2, rank: 2}, // Alfil
+	'H': {file: 3, rank: 0}, // Threeleaper
+	'C': {file: 3, rank: 1}, // Camel
+	'Z': {file: 3, rank: 2}, // Zebra
+	'G': {file: 3, rank: 3}, // Tripper
+}
+
+// betzaCompounds shorthands for the orthodox pieces in atoms.
+var betzaCompounds = map[rune]string{
+	'R': "WW",
+	'B': "FF",
+	'Q': "WWFF",
+	'K': "WF",
+}
+
+// movePattern Leaps a piece makes in every direction. Leapers make one leap,
+// riders keep leaping in the same direction until they are blocked.
+type movePattern struct {
+	directions []DirectionVec
+	// limit most leaps in one move, 0 for no limit (1 for leapers)
+	limit int
+}
+
+// leapDirections Get every direction of a leap (ex the 8 directions of the Knight's 2-1 leap).
+func leapDirections(leap DirectionVec) []DirectionVec {
+	var directions []DirectionVec
+	seen := map[DirectionVec]struct{}{}
+
+	for _, vec := range []DirectionVec{leap, {file: leap.rank, rank: leap.file}} {
+		for _, fileSign := range []int{1, -1} {
+			for _, rankSign := range []int{1, -1} {
+				direction := DirectionVec{file: vec.file * fileSign, rank: vec.rank * rankSign}
+				if _, found := seen[direction]; found {
+					continue
+				}
+
+				seen[direction] = struct{}{}
+				directions = append(directions, direction)
+			}
+		}
+	}
+
+	return directions
+}
+
+// parseBetza Get the move patterns of a piece written in Betza notation. Atoms
+// (W F D N A H C Z G) are leapers, a doubled atom is a rider (ex "NN" for the
+// Nightrider) and a number after an atom limits the leaps (ex "W3"). R, B, Q
+// and K stand for the orthodox pieces, so "BN" is the Archbishop.
+func parseBetza(notation string) ([]movePattern, error) {
+	var patterns []movePattern
+	letters := []rune(strings.TrimSpace(notation))
+
+	if len(letters) == 0 {
+		return nil, fmt.Errorf("%w: empty notation", ErrInvalidBetza)
+	}
+
+	for i := 0; i < len(letters); i++ {
+		letter := letters[i]
+
+		var group []movePattern
+		if compound, found := betzaCompounds[letter]; found {
+			compoundPatterns, _ := parseBetza(compound)
+			group = compoundPatterns
+		} else if leap, found := betzaAtoms[letter]; found {
+			group = []movePattern{{directions: leapDirections(leap), limit: 1}}
+		} else {
+			return nil, fmt.Errorf("%w: unknown atom %q in %q", ErrInvalidBetza, letter, notation)
+		}
+
+		// a doubled letter rides ("NN") and a number limits the leaps ("W3", "NN2")
+		limit := -1
+		if i+1 < len(letters) && letters[i+1] == letter {
+			limit = 0
+			i++
+		}
+
+		digits := i + 1
+		for digits < len(letters) && unicode.IsDigit(letters[digits]) {
+			digits++
+		}
+		if digits > i+1 {
+			number, err := strconv.Atoi(string(letters[i+1 : digits]))
+			if err != nil || number < 1 {
+				return nil, fmt.Errorf("%w: bad range in %q", ErrInvalidBetza, notation)
+			}
+			limit = number
+			i = digits - 1
+		}
+
+		for _, pattern := range group {
+			if limit != -1 {
+				pattern.limit = limit
+			}
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	return patterns, nil
+}
+
+type fairyPiece struct {
+	pieceProperties
+	patterns []movePattern
+}
+
+// leaps Walks from the piece in the direction, at most limit leaps (0 for no
+// limit) and stops at the edge of the board. Returns false once visit returns false.
+func (p *fairyPiece) leaps(direction DirectionVec, limit int, visit func(square *Square) bool) {
+	square := p.square
+
+	for step := 1; limit == 0 || step <= limit; step++ {
+		var err error
+		square, err = NewSquare(square.file+direction.file, square.rank+direction.rank)
+		if err != nil || !visit(square) {
+			return
+		}
+	}
+}
+
+// Moves Get the squares the piece can leap or ride to plus the squares of pieces it can capture.
+func (p *fairyPiece) Moves() []*Square {
+	var moves []*Square
+
+	for _, pattern := range p.patterns {
+		for _, direction := range pattern.directions {
+			p.leaps(direction, pattern.limit, func(square *Square) bool {
+				occupant := p.board.PieceAt(square)
+				if occupant == nil {
+					moves = append(moves, square)
+					return true
+				}
+
+				if p.captures(occupant) {
+					moves = append(moves, square)
+				}
+				return false
+			})
+		}
+	}
+
+	return moves
+}
+
+// PathTo Get the squares a rider passes on its way to the target (empty for a single leap).
+func (p *fairyPiece) PathTo(target *Square) ([]*Square, bool) {
+	for _, pattern := range p.patterns {
+		for _, direction := range pattern.directions {
+			var path []*Square
+			found := false
+
+			p.leaps(direction, pattern.limit, func(square *Square) bool {
+				if square.Index() == target.Index() {
+					found = true
+					return false
+				}
+
+				path = append(path, square)
+				return true
+			})
+
+			if found {
+				return path, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func newFairyPiece(board *Board, square *Square, pieceType PieceType, patterns []movePattern) *fairyPiece {
+	return &fairyPiece{
+		pieceProperties: pieceProperties{
+			PieceType: pieceType,
+			board:     board,
+			square:    square,
+			color:     White,
+		},
+		patterns: patterns,
+	}
+}
+
+// PieceDefinition A piece type defined by its moves in Betza notation.
+type PieceDefinition struct {
+	Type PieceType
+	// Letter letter of the piece in notation (ex "A" for the Archbishop)
+	Letter string
+	// Betza moves of the piece in Betza notation (ex "BN")
+	Betza string
+}
+
+// FairyPieces well known fairy pieces that can be chosen by their name (see ParsePieceDefinition).
+var FairyPieces = []PieceDefinition{
+	{Type: "Archbishop", Letter: "A", Betza: "BN"},
+	{Type: "Chancellor", Letter: "C", Betza: "RN"},
+	{Type: "Camel", Letter: "M", Betza: "C"},
+	{Type: "Nightrider", Letter: "H", Betza: "NN"},
+}
+
+// ParsePieceDefinition Get a piece definition from the name of one of the
+// FairyPieces (ex "Camel") or from "Name:Letter:Betza" (ex "Zebra:Z:Z").
+func ParsePieceDefinition(text string) (PieceDefinition, error) {
+	text = strings.TrimSpace(text)
+
+	for _, definition := range FairyPieces {
+		if strings.EqualFold(string(definition.Type), text) {
+			return definition, nil
+		}
+	}
+
+	parts := strings.Split(text, ":")
+	if len(parts) != 3 {
+		return PieceDefinition{}, fmt.Errorf(
+			"%w: %q is neither a known fairy piece nor Name:Letter:Betza",
+			ErrInvalidBetza,
+			text,
+		)
+	}
+
+	return PieceDefinition{
+		Type:   PieceType(capitalize(strings.TrimSpace(parts[0]))),
+		Letter: strings.ToUpper(strings.TrimSpace(parts[1])),
+		Betza:  strings.TrimSpace(parts[2]),
+	}, nil
+}
+
+// fairyDefinition A piece type defined on a board with its move patterns.
+type fairyDefinition struct {
+	PieceDefinition
+	patterns []movePattern
+}
+
+// DefinePiece Adds a new piece type that moves as its Betza notation says.
+// The piece is only known to the board and its clones, other boards can
+// define a different piece with the same name or letter. Fails with
+// ErrInvalidBetza for bad moves, an empty name or a letter that isn't one of
+// A-Z and with ErrPieceDefined if the name is a standard piece or already
+// defined or another piece uses the letter in any language.
+func (b *Board) DefinePiece(definition PieceDefinition) error {
+	patterns, err := parseBetza(definition.Betza)
+	if err != nil {
+		return err
+	}
+
+	if definition.Type == "" {
+		return fmt.Errorf("%w: the piece needs a name", ErrInvalidBetza)
+	}
+	if _, builtin := PieceTypes[definition.Type]; builtin || definition.Type == Pawn {
+		return fmt.Errorf("%w: %s is a standard piece", ErrPieceDefined, definition.Type)
+	}
+	if _, defined := b.definitions[definition.Type]; defined {
+		return fmt.Errorf("%w: %s is defined twice", ErrPieceDefined, definition.Type)
+	}
+
+	if len(definition.Letter) != 1 || definition.Letter[0] < 'A' || definition.Letter[0] > 'Z' {
+		return fmt.Errorf("%w: bad letter %q for %s", ErrInvalidBetza, definition.Letter, definition.Type)
+	}
+	// the letter must not be read as another piece in any language
+	for _, language := range Languages {
+		if language.pawnLetter == definition.Letter {
+			return fmt.Errorf("%w: %s uses the letter %s for pawns", ErrPieceDefined, language.Name, definition.Letter)
+		}
+		if pieceType, found := language.pieceType(definition.Letter); found {
+			return fmt.Errorf("%w: %s already uses the letter %s", ErrPieceDefined, pieceType, definition.Letter)
+		}
+	}
+	for pieceType, other := range b.definitions {
+		if other.Letter == definition.Letter {
+			return fmt.Errorf("%w: %s already uses the letter %s", ErrPieceDefined, pieceType, definition.Letter)
+		}
+	}
+
+	b.definitions[definition.Type] = fairyDefinition{PieceDefinition: definition, patterns: patterns}
+	return nil
+}
+
+// Definitions Get the fairy pieces defined on the board (see DefinePiece) sorted by name.
+func (b *Board) Definitions() []PieceDefinition {
+	definitions := make([]PieceDefinition, 0, len(b.definitions))
+	for _, definition := range b.definitions {
+		definitions = append(definitions, definition.PieceDefinition)
+	}
+
+	sort.Slice(definitions, func(i, j int) bool { return definitions[i].Type < definitions[j].Type })
+	return definitions
+}
+
+// language Get the language (English for the zero value) with the letters of
+// the pieces defined on the board.
+func (b *Board) language(language Language) Language {
+	if language.Code == "" {
+		language = English
+	}
+	return language.WithPieces(b.Definitions())
+}
@@ -0,0 +1,295 @@
+package game
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+// newFairyBoard Get a board that knows the FairyPieces with the pieces added on their squares.
+func newFairyBoard(t *testing.T, pieces map[string]PieceType) *Board {
+	t.Helper()
+
+	board := NewBoard()
+	for _, definition := range FairyPieces {
+		if err := board.DefinePiece(definition); err != nil {
+			t.Fatalf("can't define %s: %v", definition.Type, err)
+		}
+	}
+
+	for notation, pieceType := range pieces {
+		square, _ := NewSquareFromNotation(notation)
+		if err := board.AddPiece(pieceType, square); err != nil {
+			t.Fatalf("can't add %s to %s: %v", pieceType, notation, err)
+		}
+	}
+	return board
+}
+
+func moveNotations(piece Piece) []string {
+	var notations []string
+	for _, square := range piece.Moves() {
+		notations = append(notations, square.Notation())
+	}
+
+	sort.Strings(notations)
+	return notations
+}
+
+func TestParseBetza(t *testing.T) {
+	tests := []struct {
+		notation string
+		// directions number of directions of every pattern
+		directions []int
+		limits     []int
+	}{
+		{"N", []int{8}, []int{1}},
+		{"W", []int{4}, []int{1}},
+		{"NN", []int{8}, []int{0}},
+		{"BN", []int{4, 8}, []int{0, 1}},
+		{"RN", []int{4, 8}, []int{0, 1}},
+		{"Q", []int{4, 4}, []int{0, 0}},
+		{"C", []int{8}, []int{1}},
+		{"W3", []int{4}, []int{3}},
+		{"NN2", []int{8}, []int{2}},
+		{"AD", []int{4, 4}, []int{1, 1}},
+	}
+
+	for _, test := range tests {
+		patterns, err := parseBetza(test.notation)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.notation, err)
+			continue
+		}
+
+		if len(patterns) != len(test.directions) {
+			t.Errorf("%q: got %d patterns, expected %d", test.notation, len(patterns), len(test.directions))
+			continue
+		}
+		for i, pattern := range patterns {
+			if len(pattern.directions) != test.directions[i] || pattern.limit != test.limits[i] {
+				t.Errorf(
+					"%q pattern %d: got %d directions limit %d, expected %d limit %d",
+					test.notation, i, len(pattern.directions), pattern.limit, test.directions[i], test.limits[i],
+				)
+			}
+		}
+	}
+
+	for _, notation := range []string{"", "X", "Nx", "W0", "3"} {
+		if _, err := parseBetza(notation); !errors.Is(err, ErrInvalidBetza) {
+			t.Errorf("expected ErrInvalidBetza for %q, got %v", notation, err)
+		}
+	}
+}
+
+func TestFairyPieceMoves(t *testing.T) {
+	tests := []struct {
+		name     string
+		pieces   map[string]PieceType
+		square   string
+		expected []string
+	}{
+		{
+			"camel",
+			map[string]PieceType{"d4": "Camel"},
+			"d4",
+			[]string{"a3", "a5", "c1", "c7", "e1", "e7", "g3", "g5"},
+		},
+		{
+			"nightrider blocked",
+			map[string]PieceType{"a1": "Nightrider", "c2": Knight},
+			"a1",
+			[]string{"b3", "c5", "d7"},
+		},
+		{
+			"archbishop",
+			map[string]PieceType{"a1": "Archbishop"},
+			"a1",
+			[]string{"b2", "b3", "c2", "c3", "d4", "e5", "f6", "g7", "h8"},
+		},
+		{
+			"chancellor",
+			map[string]PieceType{"a1": "Chancellor", "a3": Rook, "c1": Rook},
+			"a1",
+			[]string{"a2", "b1", "b3", "c2"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			board := newFairyBoard(t, test.pieces)
+			square, _ := NewSquareFromNotation(test.square)
+
+			moves := moveNotations(board.PieceAt(square))
+			if len(moves) != len(test.expected) {
+				t.Fatalf("got %v, expected %v", moves, test.expected)
+			}
+			for i := range moves {
+				if moves[i] != test.expected[i] {
+					t.Fatalf("got %v, expected %v", moves, test.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestFairyPiecePathTo(t *testing.T) {
+	board := newFairyBoard(t, map[string]PieceType{"a1": "Nightrider"})
+	a1, _ := NewSquareFromNotation("a1")
+	d7, _ := NewSquareFromNotation("d7")
+
+	path, found := board.PieceAt(a1).PathTo(d7)
+	if !found || len(path) != 2 || path[0].Notation() != "b3" || path[1].Notation() != "c5" {
+		t.Errorf("got path %v (found %t), expected b3 c5", path, found)
+	}
+
+	if _, found := board.PieceAt(a1).PathTo(a1); found {
+		t.Error("nightrider shouldn't reach its own square")
+	}
+}
+
+func TestDefinePieceErrors(t *testing.T) {
+	board := newFairyBoard(t, nil)
+
+	tests := []struct {
+		name       string
+		definition PieceDefinition
+		err        error
+	}{
+		{"bad notation", PieceDefinition{Type: "Wizard", Letter: "W", Betza: "FX"}, ErrInvalidBetza},
+		{"no name", PieceDefinition{Letter: "W", Betza: "FC"}, ErrInvalidBetza},
+		{"bad letter", PieceDefinition{Type: "Wizard", Letter: "wz", Betza: "FC"}, ErrInvalidBetza},
+		{"orthodox piece", PieceDefinition{Type: Knight, Letter: "N", Betza: "N"}, ErrPieceDefined},
+		{"pawn", PieceDefinition{Type: Pawn, Letter: "P", Betza: "W"}, ErrPieceDefined},
+		{"taken letter", PieceDefinition{Type: "Wizard", Letter: "N", Betza: "FC"}, ErrPieceDefined},
+		{"German letter", PieceDefinition{Type: "Wizard", Letter: "S", Betza: "FC"}, ErrPieceDefined},
+		{"pawn letter", PieceDefinition{Type: "Wizard", Letter: "P", Betza: "FC"}, ErrPieceDefined},
+		{"fairy letter", PieceDefinition{Type: "Wizard", Letter: "A", Betza: "FC"}, ErrPieceDefined},
+		{"defined twice", PieceDefinition{Type: "Camel", Letter: "X", Betza: "Z"}, ErrPieceDefined},
+	}
+
+	for _, test := range tests {
+		if err := board.DefinePiece(test.definition); !errors.Is(err, test.err) {
+			t.Errorf("%s: got %v, expected %v", test.name, err, test.err)
+		}
+	}
+
+	// the definitions belong to the board and its clones
+	a1, _ := NewSquareFromNotation("a1")
+	if err := board.Clone().AddPiece("Camel", a1); err != nil {
+		t.Errorf("clone doesn't know the Camel: %v", err)
+	}
+	if err := NewBoard().AddPiece("Camel", a1); !errors.Is(err, ErrUnknownPiece) {
+		t.Errorf("expected ErrUnknownPiece for a Camel on a new board, got %v", err)
+	}
+
+	other := NewBoard()
+	if err := other.DefinePiece(PieceDefinition{Type: "Camel", Letter: "X", Betza: "Z"}); err != nil {
+		t.Errorf("another board can't define its own Camel: %v", err)
+	}
+}
+
+func TestParsePieceDefinition(t *testing.T) {
+	definition, err := ParsePieceDefinition("camel")
+	if err != nil || definition.Type != "Camel" {
+		t.Errorf("got %v (%v), expected the Camel", definition, err)
+	}
+
+	definition, err = ParsePieceDefinition("zebra:z:Z")
+	expected := PieceDefinition{Type: "Zebra", Letter: "Z", Betza: "Z"}
+	if err != nil || definition != expected {
+		t.Errorf("got %v (%v), expected %v", definition, err, expected)
+	}
+
+	if _, err := ParsePieceDefinition("Dragon"); !errors.Is(err, ErrInvalidBetza) {
+		t.Errorf("expected ErrInvalidBetza for an unknown piece, got %v", err)
+	}
+}
+
+func TestFairyPieceSAN(t *testing.T) {
+	position, err := ParseFEN("4k3/8/8/8/8/8/8/4K3 w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	board := position.Board()
+	if err := board.DefinePiece(FairyPieces[0]); err != nil {
+		t.Fatal(err)
+	}
+	c3, _ := NewSquareFromNotation("c3")
+	board.AddPiece("Archbishop", c3)
+
+	e4, _ := NewSquareFromNotation("e4")
+	move := Move{Piece: "Archbishop", From: c3, To: e4}
+	if san := move.SAN(board); san != "Ae4" {
+		t.Errorf("got SAN %q, expected %q", san, "Ae4")
+	}
+
+	for _, text := range []string{"Ae4", "Ac3-e4"} {
+		move, err := ParseSAN(board, text)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", text, err)
+			continue
+		}
+		if move.Piece != "Archbishop" || move.To.Notation() != "e4" {
+			t.Errorf("%q parsed as %s", text, move)
+		}
+	}
+
+	move, err = ParseLocalSAN(board, "Aa5", German)
+	if err != nil || move.Piece != "Archbishop" {
+		t.Errorf("German %q parsed as %v (%v)", "Aa5", move, err)
+	}
+
+	options := DescribeOptions{Grouping: GroupBySide, ShortNames: true}
+	expected := "White: Ke1, Ac3; Black: Ke8"
+	if described := board.Describe(options); described != expected {
+		t.Errorf("got %q, expected %q", described, expected)
+	}
+}
+
+func TestGameFairyLevels(t *testing.T) {
+	g := newTestGame(t, Config{Mode: Practice, Levels: []PieceType{Knight}, Pieces: FairyPieces[2:], Seed: 7})
+	g.SetupPreGame()
+	g.StartGame()
+
+	for i := 0; i < 3*QuestionsPerLevel; i++ {
+		answerCorrectly(t, g)
+	}
+
+	types := map[PieceType]bool{}
+	for _, piece := range g.BoardPieces() {
+		types[piece.Type()] = true
+	}
+	for _, pieceType := range []PieceType{Knight, "Camel", "Nightrider"} {
+		if !types[pieceType] {
+			t.Errorf("expected a %s on the board after three level ups, got %v", pieceType, types)
+		}
+	}
+}
+
+func TestNewWithConfigPieces(t *testing.T) {
+	tests := []struct {
+		name   string
+		pieces []PieceDefinition
+		err    error
+	}{
+		{"bad notation", []PieceDefinition{{Type: "Zebra", Letter: "Z", Betza: "ZX"}}, ErrInvalidBetza},
+		{"same letter", []PieceDefinition{FairyPieces[0], {Type: "Alfil", Letter: "A", Betza: "A"}}, ErrPieceDefined},
+		{"same piece", []PieceDefinition{FairyPieces[2], FairyPieces[2]}, ErrPieceDefined},
+	}
+
+	for _, test := range tests {
+		if _, err := NewWithConfig(Config{Mode: Practice, Pieces: test.pieces}); !errors.Is(err, test.err) {
+			t.Errorf("%s: got %v, expected %v", test.name, err, test.err)
+		}
+	}
+
+	g := newTestGame(t, Config{Mode: Practice, Pieces: FairyPieces[2:]})
+	if definitions := g.Board().Definitions(); len(definitions) != 2 || definitions[0].Type != "Camel" {
+		t.Errorf("expected the Camel and the Nightrider on the game board, got %v", definitions)
+	}
+	if letter := g.Language().Letter("Camel"); letter != "M" {
+		t.Errorf("expected the letter M for the Camel, got %q", letter)
+	}
+}
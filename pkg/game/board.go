@@ -7,22 +7,53 @@ type Piece interface {
 	Square() *Square
 	SetSquare(*Square)
 	Type() PieceType
+	// Color Get the side the piece belongs to (White unless added with AddColoredPiece).
+	Color() Color
+	// ID Get the identity of the piece, unique on its board and stable for the whole game.
+	ID() int
+	// Number Get the number of the piece among the pieces of its type (1 for the first Knight).
+	Number() int
+	// PathTo Get the squares the piece crosses on its way to the target (not including
+	// the target). Returns false if the target is not in the piece's movement pattern.
+	PathTo(*Square) ([]*Square, bool)
+}
+
+// identity Setters of the pieces made by newPiece, kept out of Piece so
+// that other packages can still implement it.
+type identity interface {
+	setIdentity(id, number int)
+	setColor(Color)
+}
+
+// identify Sets the side and identity of a piece made by newPiece.
+func identify(piece Piece, color Color, id, number int) {
+	if settable, ok := piece.(identity); ok {
+		settable.setColor(color)
+		settable.setIdentity(id, number)
+	}
 }
 
 type Board struct {
 	pieces []Piece
+	// added number of pieces of each type added so far (see Piece.Number)
+	added map[PieceType]int
+	// definitions fairy pieces that can be added to the board (see DefinePiece)
+	definitions map[PieceType]fairyDefinition
 }
 
 func NewBoard() *Board {
 	return &Board{
-		pieces: make([]Piece, 0, FileNum), // Max pieces possible
+		pieces:      make([]Piece, 0, FileNum), // Max pieces possible
+		added:       map[PieceType]int{},
+		definitions: map[PieceType]fairyDefinition{},
 	}
 }
 
-// Reset resets board (removes all pieces from the board).
+// Reset resets board (removes all pieces from the board). The defined
+// pieces stay known.
 func (b *Board) Reset() {
 	b.pieces = make([]Piece, 0, FileNum) // Max pieces possible
-
+	b.added = map[PieceType]int{}
 }
 
 // Occupied Checks if a given square is already occupied by a piece or not.
@@ -36,30 +67,155 @@ func (b *Board) Occupied(square *Square) bool {
 	return false
 }
 
-// AddPiece Add a piece to the board
+// PieceAt Get the piece standing on the given square or nil if the square is empty.
+func (b *Board) PieceAt(square *Square) Piece {
+	for _, piece := range b.pieces {
+		if piece.Square().Index() == square.Index() {
+			return piece
+		}
+	}
+
+	return nil
+}
+
+// AddPiece Add a (white) piece to the board. Fails with ErrInvalidSquare,
+// ErrSquareOccupied or ErrUnknownPiece if the piece can't be added.
 func (b *Board) AddPiece(pieceType PieceType, square *Square) error {
-	var piece Piece
+	return b.AddColoredPiece(pieceType, White, square)
+}
+
+// AddColoredPiece Add a piece of the given side to the board (see AddPiece).
+func (b *Board) AddColoredPiece(pieceType PieceType, color Color, square *Square) error {
+	if !square.valid() {
+		return fmt.Errorf("%w: can't add %s", ErrInvalidSquare, pieceType)
+	}
+
+	if occupant := b.PieceAt(square); occupant != nil {
+		return fmt.Errorf(
+			"%w: can't add %s to %s, %s stands there",
+			ErrSquareOccupied,
+			pieceType,
+			square.Notation(),
+			occupant.Type(),
+		)
+	}
+
+	piece, err := newPiece(b, pieceType, square)
+	if err != nil {
+		return err
+	}
+
+	b.added[pieceType]++
+	identify(piece, color, b.lastID()+1, b.added[pieceType])
+
+	b.pieces = append(b.pieces, piece)
+	return nil
+}
 
+// newPiece Create a piece of the given type bound to the board.
+func newPiece(b *Board, pieceType PieceType, square *Square) (Piece, error) {
 	switch pieceType {
 	case Bishop:
-		piece = NewBishop(b, square)
+		return NewBishop(b, square), nil
 	case Knight:
-		piece = NewKnight(b, square)
+		return NewKnight(b, square), nil
 	case Rook:
-		piece = NewRook(b, square)
+		return NewRook(b, square), nil
 	case King:
-		piece = NewKing(b, square)
+		return NewKing(b, square), nil
 	case Queen:
-		piece = NewQueen(b, square)
+		return NewQueen(b, square), nil
+	case Pawn:
+		return NewPawn(b, square), nil
 	default:
-		return fmt.Errorf("Unknow piece type %s", pieceType)
+		if definition, found := b.definitions[pieceType]; found {
+			return newFairyPiece(b, square, pieceType, definition.patterns), nil
+		}
+		return nil, fmt.Errorf("%w: unknown piece type %s", ErrUnknownPiece, pieceType)
+	}
+}
+
+// Clone Get a deep copy of the board. The copied pieces keep their identities
+// but are bound to the new board, so the copy can be changed without affecting
+// the original.
+func (b *Board) Clone() *Board {
+	clone := NewBoard()
+
+	for pieceType, count := range b.added {
+		clone.added[pieceType] = count
+	}
+	for pieceType, definition := range b.definitions {
+		clone.definitions[pieceType] = definition
+	}
+
+	for _, piece := range b.pieces {
+		square := *piece.Square()
+		copied, err := newPiece(clone, piece.Type(), &square)
+		if err != nil {
+			panic(err) // only known piece types can be on the board
+		}
+
+		identify(copied, piece.Color(), piece.ID(), piece.Number())
+		clone.pieces = append(clone.pieces, copied)
+	}
+
+	return clone
+}
+
+// removePiece Take the last added piece off the board (used to take back an added piece).
+func (b *Board) removePiece(piece Piece) error {
+	last := len(b.pieces) - 1
+	if last < 0 || b.pieces[last] != piece {
+		return fmt.Errorf("%w: only the last added piece can be removed", ErrUnknownPiece)
+	}
+
+	b.pieces = b.pieces[:last]
+	b.added[piece.Type()]--
+	return nil
+}
+
+// lastID Get the highest ID of the pieces on the board (0 for an empty board).
+func (b *Board) lastID() int {
+	last := 0
+	for _, piece := range b.pieces {
+		if piece.ID() > last {
+			last = piece.ID()
+		}
+	}
+	return last
+}
+
+// PieceByID Get the piece with the given ID or nil if there is no such piece.
+func (b *Board) PieceByID(id int) Piece {
+	for _, piece := range b.pieces {
+		if piece.ID() == id {
+			return piece
+		}
 	}
 
-	b.pieces = append(b.pieces, piece)
 	return nil
 }
 
-// SingularSquares Get a slice of squares to which only 1 piece can go.
+// Label Get the name of the piece used to tell it apart from other pieces of
+// the same type and side (ex "Knight" or "Knight 2" if there is more than one Knight).
+func (b *Board) Label(piece Piece) string {
+	if !b.Unique(piece) {
+		return fmt.Sprintf("%s %d", piece.Type(), piece.Number())
+	}
+	return string(piece.Type())
+}
+
+// Unique Checks if the piece is the only one of its type and side on the board.
+func (b *Board) Unique(piece Piece) bool {
+	for _, other := range b.pieces {
+		if other.Type() == piece.Type() && other.Color() == piece.Color() && other.ID() != piece.ID() {
+			return false
+		}
+	}
+	return true
+}
+
+// SingularSquares Get a slice of squares (ordered by index) to which only 1 piece can go.
 func (b *Board) SingularSquares() []*Square {
 	var allMoves = map[int]*Square{}
 	var duplicateSquareIdx = map[int]struct{}{}
@@ -80,11 +236,16 @@ func (b *Board) SingularSquares() []*Square {
 		}
 	}
 
+	// iterate by index (not over the map) to keep the order of squares
+	// the same for the same position, seeded games depend on it
 	var singularSquares []*Square
-	for _, sq := range allMoves {
-		_, duplicate := duplicateSquareIdx[sq.Index()]
+	for idx := 0; idx < FileNum*RankNum; idx++ {
+		sq, found := allMoves[idx]
+		if !found {
+			continue
+		}
 
-		if duplicate {
+		if _, duplicate := duplicateSquareIdx[idx]; duplicate {
 			continue
 		}
 
@@ -94,6 +255,18 @@ func (b *Board) SingularSquares() []*Square {
 	return singularSquares
 }
 
+// PiecesThatReachSquare Get all pieces that can reach the given square.
+func (b *Board) PiecesThatReachSquare(square *Square) []Piece {
+	var pieces []Piece
+
+	for _, piece := range b.pieces {
+		if reaches(piece, square) {
+			pieces = append(pieces, piece)
+		}
+	}
+	return pieces
+}
+
 // PieceThatReachesSquare Get piece object that can reach the given square.
 func (b *Board) PieceThatReachesSquare(square *Square) Piece {
 	sqIdx := square.Index()
@@ -108,9 +281,84 @@ func (b *Board) PieceThatReachesSquare(square *Square) Piece {
 	return nil
 }
 
-// MovePiece Moves a piece from one location to another.
-func (b *Board) MovePiece(piece Piece, toSquare *Square) {
+// MovePiece Moves a piece from one location to another, capturing a piece of the
+// other side standing there. Fails with ErrUnknownPiece if the piece is not on
+// this board, ErrSquareOccupied if a piece of the same side stands on the square
+// or ErrIllegalMove if the piece can't move there.
+func (b *Board) MovePiece(piece Piece, toSquare *Square) error {
+	if piece == nil || b.PieceByID(piece.ID()) != piece {
+		return fmt.Errorf("%w: piece is not on the board", ErrUnknownPiece)
+	}
+
+	reason := b.WhyNot(piece, toSquare)
+	switch {
+	case reason.Kind == OffBoard:
+		return fmt.Errorf("%w: can't move %s off the board", ErrInvalidSquare, piece.Type())
+	case reason.Kind == Blocked && reason.BlockerSquare.Index() == toSquare.Index():
+		return fmt.Errorf(
+			"%w: can't move %s to %s, %s stands there",
+			ErrSquareOccupied,
+			piece.Type(),
+			toSquare.Notation(),
+			reason.Blocker.Type(),
+		)
+	case reason.Kind != Reachable:
+		return fmt.Errorf(
+			"%w: %s on %s can't move to %s",
+			ErrIllegalMove,
+			piece.Type(),
+			piece.Square().Notation(),
+			toSquare.Notation(),
+		)
+	}
+
+	if captured := b.PieceAt(toSquare); captured != nil {
+		b.capture(captured)
+	}
+
 	piece.SetSquare(toSquare)
+	return nil
+}
+
+// capture Take a captured piece off the board.
+func (b *Board) capture(captured Piece) {
+	for i, piece := range b.pieces {
+		if piece == captured {
+			b.pieces = append(b.pieces[:i], b.pieces[i+1:]...)
+			return
+		}
+	}
+}
+
+// Validate Checks that every piece is of a known type, stands on a square of
+// the board and that no two pieces share a square.
+func (b *Board) Validate() error {
+	occupied := map[int]Piece{}
+
+	for _, piece := range b.pieces {
+		_, known := PieceTypes[piece.Type()]
+		if _, defined := b.definitions[piece.Type()]; !known && !defined && piece.Type() != Pawn {
+			return fmt.Errorf("%w: unknown piece type %s", ErrUnknownPiece, piece.Type())
+		}
+
+		square := piece.Square()
+		if !square.valid() {
+			return fmt.Errorf("%w: %s is not on the board", ErrInvalidSquare, piece.Type())
+		}
+
+		if other, found := occupied[square.Index()]; found {
+			return fmt.Errorf(
+				"%w: %s and %s both stand on %s",
+				ErrSquareOccupied,
+				other.Type(),
+				piece.Type(),
+				square.Notation(),
+			)
+		}
+		occupied[square.Index()] = piece
+	}
+
+	return nil
 }
 
 // TODO: Is this needed
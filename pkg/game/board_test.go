@@ -1,6 +1,7 @@
 package game
 
 import (
+	"errors"
 	"testing"
 )
 
@@ -41,17 +42,93 @@ func TestBoardMovePiece(t *testing.T) {
 
 	bishop := board.pieces[0]
 
-	d6, _ := NewSquareFromNotation("d6")
-	board.MovePiece(bishop, d6)
+	d4, _ := NewSquareFromNotation("d4")
+	if err := board.MovePiece(bishop, d4); err != nil {
+		t.Fatal(err)
+	}
 
-	if bishop.Square().Notation() != d6.Notation() {
+	if bishop.Square().Notation() != d4.Notation() {
 		t.Errorf(
 			"expected bishop to be on %s but is at %s",
-			d6.Notation(),
+			d4.Notation(),
 			bishop.Square().Notation(),
 		)
 	}
 
+	d6, _ := NewSquareFromNotation("d6")
+	if err := board.MovePiece(bishop, d6); !errors.Is(err, ErrIllegalMove) {
+		t.Errorf("expected ErrIllegalMove for Bd4-d6 but got %v", err)
+	}
+
+	f6, _ := NewSquareFromNotation("f6")
+	board.AddPiece(Knight, f6)
+	if err := board.MovePiece(bishop, f6); !errors.Is(err, ErrSquareOccupied) {
+		t.Errorf("expected ErrSquareOccupied for Bd4-f6 but got %v", err)
+	}
+
+	if bishop.Square().Notation() != d4.Notation() {
+		t.Errorf("expected refused moves to leave the bishop on d4")
+	}
+
+	other := NewBoard()
+	other.AddPiece(Bishop, a1)
+	if err := board.MovePiece(other.pieces[0], d4); !errors.Is(err, ErrUnknownPiece) {
+		t.Errorf("expected ErrUnknownPiece for a piece of another board but got %v", err)
+	}
+}
+
+func TestBoardAddPieceErrors(t *testing.T) {
+	board := NewBoard()
+
+	a1, _ := NewSquareFromNotation("a1")
+	if err := board.AddPiece(Bishop, a1); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := board.AddPiece(Knight, a1); !errors.Is(err, ErrSquareOccupied) {
+		t.Errorf("expected ErrSquareOccupied but got %v", err)
+	}
+
+	b1, _ := NewSquareFromNotation("b1")
+	if err := board.AddPiece("Dragon", b1); !errors.Is(err, ErrUnknownPiece) {
+		t.Errorf("expected ErrUnknownPiece but got %v", err)
+	}
+
+	if err := board.AddPiece(Knight, nil); !errors.Is(err, ErrInvalidSquare) {
+		t.Errorf("expected ErrInvalidSquare but got %v", err)
+	}
+
+	if _, err := NewSquareFromNotation("z9"); !errors.Is(err, ErrInvalidSquare) {
+		t.Errorf("expected ErrInvalidSquare from square notation but got %v", err)
+	}
+
+	if len(board.pieces) != 1 {
+		t.Errorf("expected refused pieces not to be added, got %d pieces", len(board.pieces))
+	}
+}
+
+func TestBoardValidate(t *testing.T) {
+	board := NewBoard()
+
+	a1, _ := NewSquareFromNotation("a1")
+	b1, _ := NewSquareFromNotation("b1")
+	board.AddPiece(Bishop, a1)
+	board.AddPiece(Knight, b1)
+
+	if err := board.Validate(); err != nil {
+		t.Errorf("expected valid board but got %v", err)
+	}
+
+	// bypass the board checks to corrupt the position
+	board.pieces[1].SetSquare(a1)
+	if err := board.Validate(); !errors.Is(err, ErrSquareOccupied) {
+		t.Errorf("expected ErrSquareOccupied but got %v", err)
+	}
+
+	board.pieces[1].SetSquare(nil)
+	if err := board.Validate(); !errors.Is(err, ErrInvalidSquare) {
+		t.Errorf("expected ErrInvalidSquare but got %v", err)
+	}
 }
 
 func TestBoardSingularSquares(t *testing.T) {
@@ -135,3 +212,105 @@ func FuzzBoardPieceThatReachesSquare(f *testing.F) {
 		}
 	})
 }
+
+func TestBoardLabel(t *testing.T) {
+	board := NewBoard()
+
+	b1, _ := NewSquareFromNotation("b1")
+	board.AddPiece(Knight, b1)
+	c1, _ := NewSquareFromNotation("c1")
+	board.AddPiece(Bishop, c1)
+
+	// a knight of the other side doesn't need telling apart
+	b8, _ := NewSquareFromNotation("b8")
+	board.AddColoredPiece(Knight, Black, b8)
+
+	knight := board.PieceAt(b1)
+	if label := board.Label(knight); label != "Knight" {
+		t.Errorf("expected label Knight for the only white knight but got %s", label)
+	}
+
+	g1, _ := NewSquareFromNotation("g1")
+	board.AddPiece(Knight, g1)
+	secondKnight := board.PieceAt(g1)
+
+	if knight.ID() == secondKnight.ID() {
+		t.Errorf("expected different IDs for different knights")
+	}
+
+	if label := board.Label(knight); label != "Knight 1" {
+		t.Errorf("expected label Knight 1 but got %s", label)
+	}
+
+	if label := board.Label(secondKnight); label != "Knight 3" {
+		t.Errorf("expected label Knight 3 but got %s", label)
+	}
+
+	// moving doesn't change the identity
+	f3, _ := NewSquareFromNotation("f3")
+	board.MovePiece(secondKnight, f3)
+	if board.PieceByID(secondKnight.ID()).Square().Notation() != "f3" || board.Label(secondKnight) != "Knight 3" {
+		t.Errorf("expected Knight 3 to keep its identity after moving")
+	}
+}
+
+func TestBoardPiecesThatReachSquare(t *testing.T) {
+	board := NewBoard()
+
+	b1, _ := NewSquareFromNotation("b1")
+	board.AddPiece(Knight, b1)
+	f3, _ := NewSquareFromNotation("f3")
+	board.AddPiece(Knight, f3)
+
+	d2, _ := NewSquareFromNotation("d2")
+	if pieces := board.PiecesThatReachSquare(d2); len(pieces) != 2 {
+		t.Errorf("expected both knights to reach d2 but got %d pieces", len(pieces))
+	}
+
+	a3, _ := NewSquareFromNotation("a3")
+	if pieces := board.PiecesThatReachSquare(a3); len(pieces) != 1 || pieces[0].Square().Notation() != "b1" {
+		t.Errorf("expected only the b1 knight to reach a3")
+	}
+}
+
+func TestBoardClone(t *testing.T) {
+	square := func(notation string) *Square {
+		sq, _ := NewSquareFromNotation(notation)
+		return sq
+	}
+
+	board := newTestBoard(map[string]PieceType{"a1": Bishop, "b1": Knight, "g1": Knight})
+	clone := board.Clone()
+
+	if clone.Render(DefaultRenderOptions()) != board.Render(DefaultRenderOptions()) {
+		t.Fatalf("expected the clone to have the same position")
+	}
+
+	knight := clone.PieceAt(square("g1"))
+	original := board.PieceAt(square("g1"))
+	if knight.ID() != original.ID() || clone.Label(knight) != board.Label(original) {
+		t.Errorf("expected the cloned piece to keep its identity")
+	}
+
+	if err := clone.MovePiece(knight, square("f3")); err != nil {
+		t.Fatal(err)
+	}
+
+	if original.Square().Notation() != "g1" || board.Occupied(square("f3")) {
+		t.Errorf("moving a piece on the clone changed the original board")
+	}
+
+	// the cloned knight now blocks the cloned bishop but not the original one
+	if len(clone.PieceAt(square("a1")).Moves()) != 7 || len(board.PieceAt(square("a1")).Moves()) != 7 {
+		t.Errorf("expected both bishops to see the whole diagonal")
+	}
+	clone.MovePiece(knight, square("d4"))
+	if moves := clone.PieceAt(square("a1")).Moves(); len(moves) != 2 {
+		t.Errorf("expected the cloned bishop to be blocked on d4 but got %d moves", len(moves))
+	}
+
+	clone.AddPiece(Rook, square("h1"))
+	if board.Occupied(square("h1")) {
+		t.Errorf("adding a piece to the clone changed the original board")
+	}
+}
@@ -0,0 +1,188 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Mode determines how the game reacts to a wrong answer.
+type Mode int
+
+const (
+	// SuddenDeath ends the game on the first wrong answer.
+	SuddenDeath Mode = iota
+	// MultipleLives ends the game once all lives are used up.
+	MultipleLives
+	// Practice is untimed, explains wrong answers and never ends the game.
+	Practice
+)
+
+var modeNames = map[Mode]string{
+	SuddenDeath:   "sudden",
+	MultipleLives: "lives",
+	Practice:      "practice",
+}
+
+func (m Mode) String() string {
+	return modeNames[m]
+}
+
+// ParseMode Get a mode from its name (ex "sudden", "lives", "practice").
+func ParseMode(name string) (Mode, bool) {
+	for mode, modeName := range modeNames {
+		if modeName == name {
+			return mode, true
+		}
+	}
+	return SuddenDeath, false
+}
+
+// AnswerMode How the player answers "Which piece can go to <square>?" questions.
+type AnswerMode int
+
+const (
+	// PieceAnswers the player picks a piece type.
+	PieceAnswers AnswerMode = iota
+	// MoveAnswers the player enters the move in algebraic notation (ex "Ng1-f3").
+	MoveAnswers
+)
+
+const (
+	DefaultLives = 3
+
+	DefaultMemorize     = 5 * time.Second
+	DefaultPeekCost     = 5
+	DefaultPeekDuration = 2 * time.Second
+)
+
+// Config Game settings that stay the same for the whole game.
+type Config struct {
+	Mode Mode
+	// Lives number of wrong answers allowed in MultipleLives mode.
+	Lives int
+	// Endless keeps the game going after the last of the Levels (see EndlessLevels).
+	Endless bool
+	// TimeLimit time to answer a question, 0 means no limit. Practice mode is always untimed.
+	TimeLimit time.Duration
+	// SilentMoves number of extra pieces that move between questions.
+	SilentMoves int
+	// Levels pieces added on every level up, nil means the default Levels.
+	Levels []PieceType
+	// Pieces fairy pieces (see Board.DefinePiece) added after the Levels.
+	Pieces []PieceDefinition
+	// Seed seed for the random positions and questions, 0 means a random seed.
+	Seed int64
+	// Daily date (ex "2022-07-30") of the daily challenge this config belongs to.
+	Daily string
+	// HintCosts points deducted for each hint of a question, nil means DefaultHintCosts.
+	HintCosts []int
+	// Memorize how long the starting position is shown, 0 means until the player is ready.
+	Memorize time.Duration
+	// PeekCost points deducted for looking at the board during the game.
+	PeekCost int
+	// PeekDuration how long the board is shown for a peek, 0 means DefaultPeekDuration.
+	PeekDuration time.Duration
+	// TrainingWheels shows the board again after this many questions (0 means never).
+	// The number of questions between showings grows by 1 every time.
+	TrainingWheels int
+	// AnnouncedMoves number of moves announced between questions. When set, pieces
+	// move only through announced moves instead of jumping to the question square.
+	AnnouncedMoves int
+	// Answers how reach questions are answered.
+	Answers AnswerMode
+	// Language piece letters of moves entered as answers, the zero value means English.
+	Language Language
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Mode:     SuddenDeath,
+		Lives:    DefaultLives,
+		Memorize: DefaultMemorize,
+		PeekCost: DefaultPeekCost,
+	}
+}
+
+// startingLives Get the number of lives a game starts with for the configured mode.
+// Practice mode has no lives since wrong answers never end the game.
+func (c Config) startingLives() int {
+	switch c.Mode {
+	case MultipleLives:
+		if c.Lives < 1 {
+			return 1
+		}
+		return c.Lives
+	case Practice:
+		return 0
+	default:
+		return 1
+	}
+}
+
+func (c Config) levels() []PieceType {
+	levels := c.Levels
+	if levels == nil {
+		levels = Levels
+	}
+	if len(c.Pieces) == 0 {
+		return levels
+	}
+
+	levels = append([]PieceType{}, levels...)
+	for _, definition := range c.Pieces {
+		levels = append(levels, definition.Type)
+	}
+	return levels
+}
+
+func (c Config) language() Language {
+	if c.Language.Code == "" {
+		return English
+	}
+	return c.Language
+}
+
+// Key Identifies the configuration so that scores are only compared
+// between games played with the same settings (ex "lives-3", "endless-sudden").
+func (c Config) Key() string {
+	if c.Daily != "" {
+		return "daily-" + c.Daily
+	}
+
+	key := c.Mode.String()
+
+	if c.Mode == MultipleLives {
+		key = fmt.Sprintf("%s-%d", key, c.startingLives())
+	}
+
+	if c.TimeLimit > 0 && c.Mode != Practice {
+		key = fmt.Sprintf("%s-%s", key, c.TimeLimit)
+	}
+
+	if c.SilentMoves > 0 {
+		key = fmt.Sprintf("%s-silent%d", key, c.SilentMoves)
+	}
+
+	if c.AnnouncedMoves > 0 {
+		key = fmt.Sprintf("%s-announced%d", key, c.AnnouncedMoves)
+	}
+
+	if c.Answers == MoveAnswers {
+		key += "-moves"
+	}
+
+	if c.TrainingWheels > 0 {
+		key = fmt.Sprintf("%s-wheels%d", key, c.TrainingWheels)
+	}
+
+	for _, definition := range c.Pieces {
+		key = fmt.Sprintf("%s-%s:%s", key, strings.ToLower(string(definition.Type)), definition.Betza)
+	}
+
+	if c.Endless {
+		key = "endless-" + key
+	}
+
+	return key
+}
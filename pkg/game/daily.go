@@ -0,0 +1,76 @@
+package game
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"time"
+)
+
+const (
+	DailyDateFormat = "2006-01-02"
+	DailyTimeLimit  = 30 * time.Second
+)
+
+// DailyConfig Get the daily challenge config for the given date. Everyone playing
+// on the same date gets the same levels, starting position and questions. Days
+// are counted in UTC so that players in all time zones share the same challenge.
+func DailyConfig(date time.Time) Config {
+	day := date.UTC().Format(DailyDateFormat)
+
+	hash := fnv.New64a()
+	hash.Write([]byte(day))
+
+	seed := int64(hash.Sum64() >> 1) // keep the seed positive
+	if seed == 0 {
+		seed = 1 // 0 means random seed
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+
+	levels := make([]PieceType, len(Levels))
+	copy(levels, Levels)
+	rng.Shuffle(len(levels), func(i, j int) {
+		levels[i], levels[j] = levels[j], levels[i]
+	})
+
+	config := DefaultConfig()
+	config.Mode = SuddenDeath
+	config.TimeLimit = DailyTimeLimit
+	config.SilentMoves = rng.Intn(2)
+	config.Levels = levels
+	config.Seed = seed
+	config.Daily = day
+
+	return config
+}
+
+// DailyResult Result of a daily challenge attempt.
+type DailyResult struct {
+	Date     string
+	Score    int
+	Level    int
+	Duration time.Duration
+	Seed     int64
+}
+
+func NewDailyResult(g *Game) DailyResult {
+	return DailyResult{
+		Date:     g.config.Daily,
+		Score:    g.Points(),
+		Level:    g.Level(),
+		Duration: g.Duration().Round(time.Second),
+		Seed:     g.seed,
+	}
+}
+
+// Share Get a one line summary of the result to share with others.
+func (r DailyResult) Share() string {
+	return fmt.Sprintf(
+		"Blind chess daily %s: score %d, level %d, time %s",
+		r.Date,
+		r.Score,
+		r.Level,
+		r.Duration,
+	)
+}
@@ -0,0 +1,69 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDailyConfig(t *testing.T) {
+	date := time.Date(2022, 7, 30, 8, 0, 0, 0, time.UTC)
+
+	config := DailyConfig(date)
+	if config.Daily != "2022-07-30" {
+		t.Errorf("expected daily date 2022-07-30 but got %s", config.Daily)
+	}
+
+	later := DailyConfig(date.Add(10 * time.Hour))
+	if later.Seed != config.Seed || later.Key() != config.Key() {
+		t.Errorf("expected the same config for the whole day")
+	}
+
+	next := DailyConfig(date.AddDate(0, 0, 1))
+	if next.Seed == config.Seed || next.Key() == config.Key() {
+		t.Errorf("expected a different config on the next day")
+	}
+
+	// 08:00 UTC is 17:00 in Tokyo and still 22:00 of the day before in Honolulu
+	for _, offset := range []int{9, -10} {
+		zone := time.FixedZone("local", offset*60*60)
+		if local := DailyConfig(date.In(zone)); local.Daily != config.Daily || local.Seed != config.Seed {
+			t.Errorf("expected the same daily challenge at UTC%+d but got %s", offset, local.Daily)
+		}
+	}
+
+	if len(config.Levels) != len(Levels) {
+		t.Errorf("expected %d levels but got %d", len(Levels), len(config.Levels))
+	}
+}
+
+func TestDailySameQuestions(t *testing.T) {
+	config := DailyConfig(time.Date(2022, 7, 30, 0, 0, 0, 0, time.UTC))
+
+	g1 := newTestGame(t, config)
+	g2 := newTestGame(t, config)
+
+	for _, g := range []*Game{g1, g2} {
+		g.SetupPreGame()
+		g.StartGame()
+	}
+
+	for i := 0; i < 25; i++ {
+		q1, q2 := g1.Question(), g2.Question()
+
+		if q1.Square.Index() != q2.Square.Index() || q1.Piece.Type() != q2.Piece.Type() {
+			t.Fatalf("question %d differs between games with the same seed", i)
+		}
+
+		answerCorrectly(t, g1)
+		answerCorrectly(t, g2)
+	}
+}
+
+func TestDailyResultShare(t *testing.T) {
+	result := DailyResult{Date: "2022-07-30", Score: 27, Level: 3, Duration: 252 * time.Second}
+
+	expected := "Blind chess daily 2022-07-30: score 27, level 3, time 4m12s"
+	if share := result.Share(); share != expected {
+		t.Errorf("expected share line %q but got %q", expected, share)
+	}
+}
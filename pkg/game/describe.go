@@ -0,0 +1,179 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DescribeGrouping How the pieces are grouped in the description of a board.
+type DescribeGrouping int
+
+const (
+	// GroupBySide lists the pieces of White and then of Black
+	// (ex "White: King g1, Rook e1, pawns f2 g2 h2; Black: King g8").
+	GroupBySide DescribeGrouping = iota
+	// GroupByType lists the pieces type by type
+	// (ex "Kings: white g1, black g8; Pawns: white f2 g2 h2, black h7").
+	GroupByType
+)
+
+// describeOrder order the piece types are described in.
+var describeOrder = []PieceType{King, Queen, Rook, Bishop, Knight, Pawn}
+
+// describedTypes Get the piece types in the order they are described, the
+// fairy pieces defined on the board (see DefinePiece) go before the pawns.
+func (b *Board) describedTypes() []PieceType {
+	pieces := len(describeOrder) - 1
+	order := append([]PieceType{}, describeOrder[:pieces]...)
+	for _, definition := range b.Definitions() {
+		order = append(order, definition.Type)
+	}
+	return append(order, describeOrder[pieces:]...)
+}
+
+// DescribeOptions Settings for describing a board in words.
+type DescribeOptions struct {
+	Grouping DescribeGrouping
+	// ShortNames uses the letters of the pieces (ex "Kg1" instead of "King g1")
+	ShortNames bool
+	// Labels names every piece with its label (ex "Knight 2 g1", see Board.Label)
+	// instead of grouping pieces of the same type, only used when grouping by side
+	Labels bool
+	// Language names and letters of the pieces, the zero value means English
+	Language Language
+	// Spoken writes squares the way they are said (ex "g one", see Square.SpokenNotation)
+	Spoken bool
+}
+
+func DefaultDescribeOptions() DescribeOptions {
+	return DescribeOptions{
+		Grouping:   GroupBySide,
+		ShortNames: false,
+		Labels:     false,
+		Language:   English,
+		Spoken:     false,
+	}
+}
+
+// Describe Get a description of the position that can be read out loud.
+func (b *Board) Describe(options DescribeOptions) string {
+	return strings.Join(b.DescribeLines(options), "; ")
+}
+
+// DescribeLines Get the description of the position (see Describe) split into
+// its groups (ex one line for White and one for Black).
+func (b *Board) DescribeLines(options DescribeOptions) []string {
+	options.Language = b.language(options.Language)
+
+	if options.Grouping == GroupByType {
+		return b.describeByType(options)
+	}
+	return b.describeBySide(options)
+}
+
+// notation Get the notation of the square in the description.
+func (o DescribeOptions) notation(square *Square) string {
+	if o.Spoken {
+		return square.SpokenNotation()
+	}
+	return square.Notation()
+}
+
+// squaresOf Get the squares (ordered by index) of the pieces of the type and side.
+func (b *Board) squaresOf(pieceType PieceType, color Color) []*Square {
+	var squares []*Square
+	for _, piece := range b.pieces {
+		if piece.Type() == pieceType && piece.Color() == color {
+			squares = append(squares, piece.Square())
+		}
+	}
+
+	sort.Slice(squares, func(i, j int) bool {
+		return squares[i].Index() < squares[j].Index()
+	})
+	return squares
+}
+
+// notations Get the notations of the squares, with a prefix (ex a piece letter) for each of them.
+func (o DescribeOptions) notations(squares []*Square, prefix string) []string {
+	if o.Spoken && prefix != "" {
+		prefix += " "
+	}
+
+	texts := make([]string, 0, len(squares))
+	for _, square := range squares {
+		texts = append(texts, prefix+o.notation(square))
+	}
+	return texts
+}
+
+func capitalize(text string) string {
+	if text == "" {
+		return text
+	}
+	return strings.ToUpper(text[:1]) + text[1:]
+}
+
+func (b *Board) describeBySide(options DescribeOptions) []string {
+	language := options.Language
+	var sides []string
+
+	for _, color := range []Color{White, Black} {
+		var parts []string
+
+		for _, pieceType := range b.describedTypes() {
+			squares := b.squaresOf(pieceType, color)
+
+			switch {
+			case len(squares) == 0:
+				continue
+			case options.ShortNames:
+				parts = append(parts, strings.Join(options.notations(squares, language.Letter(pieceType)), " "))
+			case options.Labels:
+				for _, square := range squares {
+					parts = append(parts, fmt.Sprintf("%s %s", language.Translate(b.Label(b.PieceAt(square))), options.notation(square)))
+				}
+			case len(squares) == 1:
+				parts = append(parts, fmt.Sprintf("%s %s", language.PieceName(pieceType), options.notation(squares[0])))
+			default:
+				parts = append(parts, fmt.Sprintf("%s %s", language.PluralName(pieceType), strings.Join(options.notations(squares, ""), " ")))
+			}
+		}
+
+		if len(parts) > 0 {
+			sides = append(sides, language.ColorName(color)+": "+strings.Join(parts, ", "))
+		}
+	}
+
+	return sides
+}
+
+func (b *Board) describeByType(options DescribeOptions) []string {
+	language := options.Language
+	var groups []string
+
+	for _, pieceType := range b.describedTypes() {
+		var parts []string
+		for _, color := range []Color{White, Black} {
+			if squares := b.squaresOf(pieceType, color); len(squares) > 0 {
+				parts = append(parts, fmt.Sprintf("%s %s", strings.ToLower(language.ColorName(color)), strings.Join(options.notations(squares, ""), " ")))
+			}
+		}
+
+		if len(parts) == 0 {
+			continue
+		}
+
+		name := language.Letter(pieceType)
+		switch {
+		case pieceType == Pawn && options.ShortNames:
+			name = language.pawnLetter
+		case !options.ShortNames:
+			name = capitalize(language.PluralName(pieceType))
+		}
+		groups = append(groups, name+": "+strings.Join(parts, ", "))
+	}
+
+	return groups
+}
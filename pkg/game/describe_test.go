@@ -0,0 +1,106 @@
+package game
+
+import "testing"
+
+func TestBoardDescribe(t *testing.T) {
+	position, err := ParseFEN("6k1/5ppp/8/8/8/8/5PPP/4R1K1 w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	board := position.Board()
+
+	tests := []struct {
+		name     string
+		options  DescribeOptions
+		expected string
+	}{
+		{
+			"by side",
+			DefaultDescribeOptions(),
+			"White: King g1, Rook e1, pawns f2 g2 h2; Black: King g8, pawns f7 g7 h7",
+		},
+		{
+			"by side short",
+			DescribeOptions{Grouping: GroupBySide, ShortNames: true},
+			"White: Kg1, Re1, f2 g2 h2; Black: Kg8, f7 g7 h7",
+		},
+		{
+			"by type",
+			DescribeOptions{Grouping: GroupByType},
+			"Kings: white g1, black g8; Rooks: white e1; Pawns: white f2 g2 h2, black f7 g7 h7",
+		},
+		{
+			"by type short",
+			DescribeOptions{Grouping: GroupByType, ShortNames: true},
+			"K: white g1, black g8; R: white e1; P: white f2 g2 h2, black f7 g7 h7",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if described := board.Describe(test.options); described != test.expected {
+				t.Errorf("got %q, expected %q", described, test.expected)
+			}
+		})
+	}
+}
+
+func TestBoardDescribeLabels(t *testing.T) {
+	board := NewBoard()
+	for _, notation := range []string{"b1", "g1"} {
+		square, _ := NewSquareFromNotation(notation)
+		board.AddPiece(Knight, square)
+	}
+
+	options := DescribeOptions{Grouping: GroupBySide, Labels: true}
+	expected := "White: Knight 1 b1, Knight 2 g1"
+	if described := board.Describe(options); described != expected {
+		t.Errorf("got %q, expected %q", described, expected)
+	}
+
+	if described := NewBoard().Describe(DefaultDescribeOptions()); described != "" {
+		t.Errorf("empty board described as %q", described)
+	}
+}
+
+func TestBoardDescribeGerman(t *testing.T) {
+	position, err := ParseFEN("6k1/5ppp/8/8/8/8/5PPP/4R1K1 w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	options := DescribeOptions{Grouping: GroupBySide, Language: German}
+	expected := "Weiß: König g1, Turm e1, Bauern f2 g2 h2; Schwarz: König g8, Bauern f7 g7 h7"
+	if described := position.Board().Describe(options); described != expected {
+		t.Errorf("got %q, expected %q", described, expected)
+	}
+
+	options = DescribeOptions{Grouping: GroupByType, ShortNames: true, Language: German}
+	expected = "K: weiß g1, schwarz g8; T: weiß e1; B: weiß f2 g2 h2, schwarz f7 g7 h7"
+	if described := position.Board().Describe(options); described != expected {
+		t.Errorf("got %q, expected %q", described, expected)
+	}
+}
+
+func TestBoardDescribeLinesSpoken(t *testing.T) {
+	position, err := ParseFEN("6k1/5ppp/8/8/8/8/5PPP/4R1K1 w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	options := DescribeOptions{Grouping: GroupBySide, ShortNames: true, Spoken: true}
+	expected := []string{
+		"White: K g one, R e one, f two g two h two",
+		"Black: K g eight, f seven g seven h seven",
+	}
+
+	lines := position.Board().DescribeLines(options)
+	if len(lines) != len(expected) {
+		t.Fatalf("got %q, expected %q", lines, expected)
+	}
+	for i := range expected {
+		if lines[i] != expected[i] {
+			t.Errorf("got %q, expected %q", lines[i], expected[i])
+		}
+	}
+}
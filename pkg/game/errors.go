@@ -0,0 +1,35 @@
+package game
+
+import "errors"
+
+var (
+	// ErrInvalidSquare the square is not on the board.
+	ErrInvalidSquare = errors.New("invalid square")
+	// ErrSquareOccupied another piece already stands on the square.
+	ErrSquareOccupied = errors.New("square is occupied")
+	// ErrUnknownPiece the piece type is unknown or the piece is not on the board.
+	ErrUnknownPiece = errors.New("unknown piece")
+	// ErrInvalidBetza the text is not a piece's moves in Betza notation (or the
+	// piece definition has a bad name or letter).
+	ErrInvalidBetza = errors.New("invalid Betza notation")
+	// ErrPieceDefined the name or letter of a piece definition is already taken.
+	ErrPieceDefined = errors.New("piece is already defined")
+
+	// ErrInvalidFEN the text is not a position in Forsyth-Edwards Notation.
+	ErrInvalidFEN = errors.New("invalid FEN")
+
+	// ErrInvalidSAN the text is not a move in standard algebraic notation.
+	ErrInvalidSAN = errors.New("invalid move notation")
+	// ErrIllegalMove no piece can make the move.
+	ErrIllegalMove = errors.New("illegal move")
+	// ErrAmbiguousMove more than one piece can make the move.
+	ErrAmbiguousMove = errors.New("ambiguous move")
+	// ErrInvalidAnswer the answer doesn't fit the question.
+	ErrInvalidAnswer = errors.New("invalid answer")
+	// ErrNothingToUndo the game history is empty.
+	ErrNothingToUndo = errors.New("nothing to undo")
+	// ErrNotPlaying the game hasn't started yet or is already over.
+	ErrNotPlaying = errors.New("game is not in play")
+	// ErrAmbiguousAnswer the answer names a piece type but there is more than one piece of the type.
+	ErrAmbiguousAnswer = errors.New("ambiguous answer")
+)
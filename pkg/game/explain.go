@@ -0,0 +1,108 @@
+package game
+
+type ReasonKind int
+
+const (
+	// Reachable the piece can move to the square.
+	Reachable ReasonKind = iota
+	// OutOfPattern the square is not in the piece's movement pattern.
+	OutOfPattern
+	// Blocked a piece stands on the path to the square (or on the square itself).
+	Blocked
+	// OffBoard the square is not on the board.
+	OffBoard
+)
+
+// Reason Why a piece can (or can't) move to a square.
+type Reason struct {
+	Kind ReasonKind
+	// Blocker the piece in the way (only for Blocked)
+	Blocker Piece
+	// BlockerSquare where the blocker stands (only for Blocked)
+	BlockerSquare *Square
+}
+
+// WhyNot Find out why the piece can't move to the square. Kind is Reachable if it can.
+func (b *Board) WhyNot(piece Piece, square *Square) Reason {
+	if !square.valid() {
+		return Reason{Kind: OffBoard}
+	}
+
+	path, ok := piece.PathTo(square)
+	if !ok {
+		return Reason{Kind: OutOfPattern}
+	}
+
+	for _, sq := range path {
+		if blocker := b.PieceAt(sq); blocker != nil {
+			return Reason{Kind: Blocked, Blocker: blocker, BlockerSquare: sq}
+		}
+	}
+
+	// the target square itself counts unless the piece standing there can be
+	// captured (pawns capture diagonally only and only move diagonally to capture)
+	occupant := b.PieceAt(square)
+	diagonal := piece.Type() == Pawn && square.file != piece.Square().file
+	switch {
+	case occupant != nil && (occupant.Color() == piece.Color() || (piece.Type() == Pawn && !diagonal)):
+		return Reason{Kind: Blocked, Blocker: occupant, BlockerSquare: square}
+	case occupant == nil && diagonal:
+		return Reason{Kind: OutOfPattern}
+	}
+
+	return Reason{Kind: Reachable}
+}
+
+// Explanation Why a piece can't reach a square.
+type Explanation struct {
+	Piece PieceType
+	// Label tells the piece apart from other pieces of its type (see Board.Label)
+	Label string
+	From  *Square
+	To    *Square
+	// Blocker type of the piece standing in the way, empty if the square
+	// is not in the piece's movement pattern at all
+	Blocker       PieceType
+	BlockerSquare *Square
+}
+
+// Explain Explains why each piece of the given type can't reach the square.
+// Pieces that can reach the square are left out.
+func (b *Board) Explain(pieceType PieceType, square *Square) []Explanation {
+	var explanations []Explanation
+
+	for _, piece := range b.pieces {
+		if piece.Type() != pieceType {
+			continue
+		}
+
+		if explanation, ok := b.ExplainPiece(piece, square); ok {
+			explanations = append(explanations, explanation)
+		}
+	}
+
+	return explanations
+}
+
+// ExplainPiece Explains why the piece can't reach the square.
+// Returns false if the piece can reach it.
+func (b *Board) ExplainPiece(piece Piece, square *Square) (Explanation, bool) {
+	reason := b.WhyNot(piece, square)
+	if reason.Kind == Reachable {
+		return Explanation{}, false
+	}
+
+	explanation := Explanation{
+		Piece: piece.Type(),
+		Label: b.Label(piece),
+		From:  piece.Square(),
+		To:    square,
+	}
+
+	if reason.Kind == Blocked {
+		explanation.Blocker = reason.Blocker.Type()
+		explanation.BlockerSquare = reason.BlockerSquare
+	}
+
+	return explanation, true
+}
@@ -0,0 +1,149 @@
+package game
+
+import (
+	"testing"
+)
+
+func TestBoardExplainBlocked(t *testing.T) {
+	board := NewBoard()
+
+	a3, _ := NewSquareFromNotation("a3")
+	board.AddPiece(Rook, a3)
+
+	a5, _ := NewSquareFromNotation("a5")
+	board.AddPiece(Knight, a5)
+
+	a7, _ := NewSquareFromNotation("a7")
+	explanations := board.Explain(Rook, a7)
+
+	if len(explanations) != 1 {
+		t.Fatalf("expected 1 explanation but got %d", len(explanations))
+	}
+
+	explanation := explanations[0]
+	if explanation.Blocker != Knight || explanation.BlockerSquare.Notation() != "a5" {
+		t.Errorf("expected rook to be blocked by the knight on a5 but got %+v", explanation)
+	}
+
+	if explanation.From.Notation() != "a3" || explanation.To.Notation() != "a7" {
+		t.Errorf("wrong squares in explanation %+v", explanation)
+	}
+}
+
+func TestBoardExplainOutOfPattern(t *testing.T) {
+	board := NewBoard()
+
+	c1, _ := NewSquareFromNotation("c1")
+	board.AddPiece(Bishop, c1)
+
+	b1, _ := NewSquareFromNotation("b1")
+	board.AddPiece(Knight, b1)
+
+	c5, _ := NewSquareFromNotation("c5")
+	for _, pieceType := range []PieceType{Bishop, Knight} {
+		explanations := board.Explain(pieceType, c5)
+
+		if len(explanations) != 1 || explanations[0].Blocker != "" {
+			t.Errorf("expected %s to be unable to move to c5 at all, got %+v", pieceType, explanations)
+		}
+	}
+
+	// reachable squares are not explained
+	e3, _ := NewSquareFromNotation("e3")
+	if explanations := board.Explain(Bishop, e3); len(explanations) != 0 {
+		t.Errorf("expected no explanation for a reachable square, got %+v", explanations)
+	}
+}
+
+func TestGameReplay(t *testing.T) {
+	g := newTestGame(t, Config{Mode: Practice, SilentMoves: 1})
+	g.SetupPreGame()
+	g.StartGame()
+
+	for i := 0; i < 3*QuestionsPerLevel; i++ {
+		answerCorrectly(t, g)
+	}
+
+	replay := NewReplay(g.History())
+	for {
+		if _, ok := replay.Next(); !ok {
+			break
+		}
+	}
+
+	expected := g.Board().Render(DefaultRenderOptions())
+	if replayed := replay.Board().Render(DefaultRenderOptions()); replayed != expected {
+		t.Errorf("replayed board:\n%s\ndiffers from game board:\n%s", replayed, expected)
+	}
+}
+
+func TestPiecePathTo(t *testing.T) {
+	board := NewBoard()
+
+	d4, _ := NewSquareFromNotation("d4")
+	queen := NewQueen(board, d4)
+
+	h8, _ := NewSquareFromNotation("h8")
+	path, ok := queen.PathTo(h8)
+	if !ok {
+		t.Fatal("expected h8 to be on the queen's diagonal")
+	}
+
+	expected := []string{"e5", "f6", "g7"}
+	if len(path) != len(expected) {
+		t.Fatalf("expected path %v but got %d squares", expected, len(path))
+	}
+	for idx, sq := range path {
+		if sq.Notation() != expected[idx] {
+			t.Errorf("expected %s at path index %d but got %s", expected[idx], idx, sq.Notation())
+		}
+	}
+
+	e6, _ := NewSquareFromNotation("e6")
+	if _, ok := queen.PathTo(e6); ok {
+		t.Errorf("expected e6 not to be on any of the queen's rays")
+	}
+
+	knight := NewKnight(board, d4)
+	if path, ok := knight.PathTo(e6); !ok || len(path) != 0 {
+		t.Errorf("expected knight to jump to e6 with an empty path, got %v %t", path, ok)
+	}
+}
+
+func TestBoardWhyNot(t *testing.T) {
+	board := NewBoard()
+
+	a3, _ := NewSquareFromNotation("a3")
+	board.AddPiece(Rook, a3)
+	rook := board.PieceAt(a3)
+
+	a5, _ := NewSquareFromNotation("a5")
+	board.AddPiece(Knight, a5)
+
+	tests := []struct {
+		notation string
+		kind     ReasonKind
+	}{
+		{"a4", Reachable},
+		{"h3", Reachable},
+		{"a7", Blocked},
+		{"a5", Blocked},
+		{"b4", OutOfPattern},
+	}
+
+	for _, test := range tests {
+		square, _ := NewSquareFromNotation(test.notation)
+		if reason := board.WhyNot(rook, square); reason.Kind != test.kind {
+			t.Errorf("expected reason %d for rook to %s but got %d", test.kind, test.notation, reason.Kind)
+		}
+	}
+
+	a7, _ := NewSquareFromNotation("a7")
+	if reason := board.WhyNot(rook, a7); reason.Blocker.Type() != Knight || reason.BlockerSquare.Notation() != "a5" {
+		t.Errorf("expected knight on a5 to block, got %+v", reason)
+	}
+
+	if reason := board.WhyNot(rook, nil); reason.Kind != OffBoard {
+		t.Errorf("expected OffBoard for a nil square but got %d", reason.Kind)
+	}
+}
@@ -0,0 +1,80 @@
+package game
+
+import (
+	"errors"
+)
+
+type HintKind int
+
+const (
+	// ColorHint the colour of the square the piece stands on.
+	ColorHint HintKind = iota
+	// FileHint the file the piece stands on.
+	FileHint
+	// LocationHint the square the piece stands on.
+	LocationHint
+)
+
+var hintNames = map[HintKind]string{
+	ColorHint:    "color",
+	FileHint:     "file",
+	LocationHint: "location",
+}
+
+func (k HintKind) String() string {
+	return hintNames[k]
+}
+
+// PointsPerAnswer points scored for every correct answer.
+const PointsPerAnswer = 10
+
+// DefaultHintCosts points deducted for the first, second and third hint of a question.
+var DefaultHintCosts = []int{2, 3, 5}
+
+var ErrNoMoreHints = errors.New("no more hints for this question")
+
+// Hint A hint about the piece that answers the current question. Every hint of
+// the same question gives away more (see HintKind).
+type Hint struct {
+	Kind HintKind
+	// Square where the piece stands, only the part given by Kind should be shown
+	Square *Square
+	// Cost points deducted for the hint
+	Cost int
+}
+
+func (c Config) hintCosts() []int {
+	if c.HintCosts == nil {
+		return DefaultHintCosts
+	}
+	return c.HintCosts
+}
+
+// Hint Get the next hint for the current question and deduct its cost from the points.
+func (g *Game) Hint() (Hint, error) {
+	if g.currState != Play {
+		return Hint{}, ErrNotPlaying
+	}
+
+	costs := g.config.hintCosts()
+	if g.hintsUsed >= len(costs) || g.hintsUsed > int(LocationHint) {
+		return Hint{}, ErrNoMoreHints
+	}
+
+	hint := Hint{
+		Kind:   HintKind(g.hintsUsed),
+		Square: g.question.Piece.Square(),
+		Cost:   costs[g.hintsUsed],
+	}
+
+	g.hintsUsed++
+	g.Penalty += hint.Cost
+	g.record("hint %s cost %d", hint.Kind, hint.Cost)
+
+	return hint, nil
+}
+
+// Points Get the points scored so far (correct answers minus the cost of hints).
+func (g *Game) Points() int {
+	return g.Score*PointsPerAnswer - g.Penalty
+}
@@ -0,0 +1,58 @@
+package game
+
+import (
+	"testing"
+)
+
+func TestGameHint(t *testing.T) {
+	g := newTestGame(t, Config{Mode: Practice})
+	g.SetupPreGame()
+	g.StartGame()
+
+	questionPiece, _ := g.QuestionPieceAndSquare()
+
+	for idx, kind := range []HintKind{ColorHint, FileHint, LocationHint} {
+		hint, err := g.Hint()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if hint.Kind != kind || hint.Cost != DefaultHintCosts[idx] {
+			t.Errorf("expected %s hint costing %d but got %+v", kind, DefaultHintCosts[idx], hint)
+		}
+
+		if hint.Square.Index() != questionPiece.Square().Index() {
+			t.Errorf("expected hint about the square of the question piece")
+		}
+	}
+
+	if _, err := g.Hint(); err != ErrNoMoreHints {
+		t.Errorf("expected ErrNoMoreHints but got %v", err)
+	}
+
+	answerCorrectly(t, g)
+
+	expected := PointsPerAnswer - (2 + 3 + 5)
+	if g.Points() != expected {
+		t.Errorf("expected %d points but got %d", expected, g.Points())
+	}
+
+	// hints are counted per question
+	if hint, err := g.Hint(); err != nil || hint.Kind != ColorHint {
+		t.Errorf("expected a colour hint for the next question, got %+v %v", hint, err)
+	}
+}
+
+func TestGameHintCustomCosts(t *testing.T) {
+	g := newTestGame(t, Config{Mode: SuddenDeath, HintCosts: []int{1}})
+	g.SetupPreGame()
+	g.StartGame()
+
+	if hint, _ := g.Hint(); hint.Cost != 1 {
+		t.Errorf("expected hint to cost 1 but got %d", hint.Cost)
+	}
+
+	if _, err := g.Hint(); err != ErrNoMoreHints {
+		t.Errorf("expected a single hint per question but got %v", err)
+	}
+}
@@ -0,0 +1,192 @@
+package game
+
+import "strings"
+
+// Language Names of the pieces and the letters used for them in notation.
+type Language struct {
+	// Code ISO 639-1 code of the language (ex "de")
+	Code string
+	Name string
+
+	names   map[PieceType]string
+	plurals map[PieceType]string
+	colors  map[Color]string
+	letters map[PieceType]string
+	// pawnLetter letter for pawns where they need one (ex the headings of Board.Describe)
+	pawnLetter string
+	// pieces letters of fairy pieces (see WithPieces)
+	pieces map[PieceType]string
+}
+
+var English = Language{
+	Code: "en",
+	Name: "English",
+	names: map[PieceType]string{
+		King:   "King",
+		Queen:  "Queen",
+		Rook:   "Rook",
+		Bishop: "Bishop",
+		Knight: "Knight",
+		Pawn:   "Pawn",
+	},
+	plurals: map[PieceType]string{
+		King:   "kings",
+		Queen:  "queens",
+		Rook:   "rooks",
+		Bishop: "bishops",
+		Knight: "knights",
+		Pawn:   "pawns",
+	},
+	colors: map[Color]string{
+		White: "White",
+		Black: "Black",
+	},
+	letters:    pieceLetters,
+	pawnLetter: "P",
+}
+
+var German = Language{
+	Code: "de",
+	Name: "Deutsch",
+	names: map[PieceType]string{
+		King:   "König",
+		Queen:  "Dame",
+		Rook:   "Turm",
+		Bishop: "Läufer",
+		Knight: "Springer",
+		Pawn:   "Bauer",
+	},
+	plurals: map[PieceType]string{
+		King:   "Könige",
+		Queen:  "Damen",
+		Rook:   "Türme",
+		Bishop: "Läufer",
+		Knight: "Springer",
+		Pawn:   "Bauern",
+	},
+	colors: map[Color]string{
+		White: "Weiß",
+		Black: "Schwarz",
+	},
+	letters: map[PieceType]string{
+		King:   "K",
+		Queen:  "D",
+		Rook:   "T",
+		Bishop: "L",
+		Knight: "S",
+	},
+	pawnLetter: "B",
+}
+
+// Languages all languages pieces can be named in.
+var Languages = []Language{English, German}
+
+// LanguageByCode Get a language from its code (ex "en", "de").
+func LanguageByCode(code string) (Language, bool) {
+	for _, language := range Languages {
+		if language.Code == strings.ToLower(code) {
+			return language, true
+		}
+	}
+	return Language{}, false
+}
+
+// PieceName Get the name of the piece type in the language (ex "Springer").
+func (l Language) PieceName(pieceType PieceType) string {
+	if name, found := l.names[pieceType]; found {
+		return name
+	}
+	return string(pieceType)
+}
+
+// PluralName Get the name of several pieces of the type (ex "knights").
+func (l Language) PluralName(pieceType PieceType) string {
+	if name, found := l.plurals[pieceType]; found {
+		return name
+	}
+	return string(pieceType) + "s"
+}
+
+// PieceTypeByName Get the piece type from its name or the name of several
+// pieces of the type, in any case (ex "Springer", "knights").
+func (l Language) PieceTypeByName(name string) (PieceType, bool) {
+	for pieceType, pieceName := range l.names {
+		if strings.EqualFold(pieceName, name) {
+			return pieceType, true
+		}
+	}
+
+	for pieceType, plural := range l.plurals {
+		if strings.EqualFold(plural, name) {
+			return pieceType, true
+		}
+	}
+	return "", false
+}
+
+// ColorName Get the name of the side (ex "Weiß").
+func (l Language) ColorName(color Color) string {
+	if name, found := l.colors[color]; found {
+		return name
+	}
+	return string(color)
+}
+
+// Letter Get the letter of the piece type in notation (ex "S" for a German Knight).
+// Pawns have no letter.
+func (l Language) Letter(pieceType PieceType) string {
+	if letter, found := l.letters[pieceType]; found {
+		return letter
+	}
+	if letter, found := l.pieces[pieceType]; found {
+		return letter
+	}
+	return pieceType.Letter()
+}
+
+// pieceType Get the piece type from its letter in notation.
+func (l Language) pieceType(letter string) (PieceType, bool) {
+	for pieceType, pieceLetter := range l.letters {
+		if pieceLetter == letter {
+			return pieceType, true
+		}
+	}
+
+	for pieceType, pieceLetter := range l.pieces {
+		if pieceLetter == letter {
+			return pieceType, true
+		}
+	}
+	return "", false
+}
+
+// WithPieces Get a copy of the language that knows the letters of the fairy
+// pieces (see Board.DefinePiece). Fairy pieces have the same letter in every language.
+func (l Language) WithPieces(definitions []PieceDefinition) Language {
+	if len(definitions) == 0 {
+		return l
+	}
+
+	pieces := make(map[PieceType]string, len(l.pieces)+len(definitions))
+	for pieceType, letter := range l.pieces {
+		pieces[pieceType] = letter
+	}
+	for _, definition := range definitions {
+		pieces[definition.Type] = definition.Letter
+	}
+
+	l.pieces = pieces
+	return l
+}
+
+// Translate Get a label of a piece (see Board.Label) in the language
+// (ex "Springer 2" for "Knight 2").
+func (l Language) Translate(label string) string {
+	name, number, numbered := strings.Cut(label, " ")
+
+	name = l.PieceName(PieceType(name))
+	if numbered {
+		return name + " " + number
+	}
+	return name
+}
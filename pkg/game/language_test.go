@@ -0,0 +1,58 @@
+package game
+
+import "testing"
+
+func TestLanguageByCode(t *testing.T) {
+	for _, code := range []string{"en", "de", "DE"} {
+		if _, found := LanguageByCode(code); !found {
+			t.Errorf("expected a language for %q", code)
+		}
+	}
+
+	if _, found := LanguageByCode("xx"); found {
+		t.Error("expected no language for xx")
+	}
+}
+
+func TestLanguageTranslate(t *testing.T) {
+	tests := []struct {
+		language Language
+		label    string
+		expected string
+	}{
+		{English, "Knight 2", "Knight 2"},
+		{German, "Knight 2", "Springer 2"},
+		{German, "Queen", "Dame"},
+		{German, "Unicorn", "Unicorn"},
+	}
+
+	for _, test := range tests {
+		if translated := test.language.Translate(test.label); translated != test.expected {
+			t.Errorf("expected %q for %q in %s but got %q", test.expected, test.label, test.language.Name, translated)
+		}
+	}
+}
+
+func TestLanguagePieceTypeByName(t *testing.T) {
+	tests := []struct {
+		language Language
+		name     string
+		expected PieceType
+	}{
+		{English, "queen", Queen},
+		{English, "Knights", Knight},
+		{German, "dame", Queen},
+		{German, "Läufer", Bishop},
+		{German, "bauern", Pawn},
+	}
+
+	for _, test := range tests {
+		if pieceType, found := test.language.PieceTypeByName(test.name); !found || pieceType != test.expected {
+			t.Errorf("expected %s for %q in %s but got %q", test.expected, test.name, test.language.Name, pieceType)
+		}
+	}
+
+	if _, found := German.PieceTypeByName("queen"); found {
+		t.Error("expected no German piece named queen")
+	}
+}
@@ -0,0 +1,93 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Move A piece moving from one square to another.
+type Move struct {
+	Piece PieceType
+	// From is nil for pieces that were added to the board
+	From *Square
+	To   *Square
+	// Promotion piece a pawn becomes on the last rank (empty if not a promotion)
+	Promotion PieceType
+}
+
+func (m Move) String() string {
+	if m.From == nil {
+		return fmt.Sprintf("%s added on %s", m.Piece, m.To.Notation())
+	}
+	return fmt.Sprintf("%s %s-%s", m.Piece, m.From.Notation(), m.To.Notation())
+}
+
+// LongAlgebraic Get the move in long algebraic notation (ex "Nc3-e4").
+func (m Move) LongAlgebraic() string {
+	return m.LocalLongAlgebraic(English)
+}
+
+// LocalLongAlgebraic Get the move in long algebraic notation with the piece
+// letters of the language (ex "Sc3-e4" in German).
+func (m Move) LocalLongAlgebraic(language Language) string {
+	text := fmt.Sprintf("%s%s-%s", language.Letter(m.Piece), m.From.Notation(), m.To.Notation())
+	if m.Promotion != "" {
+		text += "=" + language.Letter(m.Promotion)
+	}
+	return text
+}
+
+// UCI Get the move in the notation of the Universal Chess Interface (ex "g1f3", "e7e8q").
+func (m Move) UCI() string {
+	text := m.From.Notation() + m.To.Notation()
+	if m.Promotion != "" {
+		text += strings.ToLower(m.Promotion.Letter())
+	}
+	return text
+}
+
+// ICCF Get the move in ICCF numeric notation (ex "7163" for g1-f3, "57581" for e7-e8=Q).
+func (m Move) ICCF() string {
+	text := m.From.NumericNotation() + m.To.NumericNotation()
+	if digit, found := Contains(iccfPromotions, m.Promotion); found {
+		text += fmt.Sprint(digit + 1)
+	}
+	return text
+}
+
+// Replay Plays a list of moves (see Game.History) one at a time on a separate board.
+type Replay struct {
+	board *Board
+	moves []Move
+	next  int
+}
+
+func NewReplay(moves []Move) *Replay {
+	return &Replay{
+		board: NewBoard(),
+		moves: moves,
+		next:  0,
+	}
+}
+
+// Next Plays the next move on the replay board. Returns false if there are no moves left.
+func (r *Replay) Next() (Move, bool) {
+	if r.next >= len(r.moves) {
+		return Move{}, false
+	}
+
+	move := r.moves[r.next]
+	r.next++
+
+	if move.From == nil {
+		r.board.AddPiece(move.Piece, move.To)
+	} else {
+		r.board.MovePiece(r.board.PieceAt(move.From), move.To)
+	}
+
+	return move, true
+}
+
+func (r *Replay) Board() *Board {
+	return r.board
+}
@@ -45,6 +45,8 @@ const (
 	Rook             = "Rook"
 	King             = "King"
 	Queen            = "Queen"
+	// Pawn only exists in two-sided positions (see Position)
+	Pawn = "Pawn"
 )
 
 var PieceTypes = map[PieceType]struct{}{
@@ -55,10 +57,26 @@ var PieceTypes = map[PieceType]struct{}{
 	Queen:  {},
 }
 
+var pieceLetters = map[PieceType]string{
+	Bishop: "B",
+	Knight: "N",
+	Rook:   "R",
+	King:   "K",
+	Queen:  "Q",
+}
+
+// Letter Get the (English) letter used for the piece type in notation (ex "N" for Knight).
+func (t PieceType) Letter() string {
+	return pieceLetters[t]
+}
+
 type pieceProperties struct {
 	board      *Board
 	square     *Square
 	directions []DirectionVec
+	color      Color
+	id         int
+	number     int
 	PieceType
 }
 
@@ -66,6 +84,32 @@ func (p pieceProperties) Type() PieceType {
 	return p.PieceType
 }
 
+func (p pieceProperties) Color() Color {
+	return p.color
+}
+
+func (p *pieceProperties) setColor(color Color) {
+	p.color = color
+}
+
+// captures Checks if the piece can capture the other piece (pieces of the same color block each other).
+func (p pieceProperties) captures(other Piece) bool {
+	return other.Color() != p.color
+}
+
+func (p pieceProperties) ID() int {
+	return p.id
+}
+
+func (p pieceProperties) Number() int {
+	return p.number
+}
+
+func (p *pieceProperties) setIdentity(id, number int) {
+	p.id = id
+	p.number = number
+}
+
 func (p pieceProperties) Square() *Square {
 	return p.square
 }
@@ -82,34 +126,83 @@ type slidingPiece struct {
 func (p *slidingPiece) Moves() []*Square {
 	var moves []*Square
 
-	var newSquare *Square
 	for _, direction := range p.directions {
-		newSquare = p.square
-
-		for {
-			newFile := newSquare.file + direction.file
-			newRank := newSquare.rank + direction.rank
-
-			var err error
-			newSquare, err = NewSquare(newFile, newRank)
-			if err != nil {
-				// we are out of bounds -> this square doesn't exist
-				// move to next direction
-				break
-			}
-
-			// if square exists but its occupied -> move to next direction
-			if p.board.Occupied(newSquare) {
-				break
-			}
-
-			moves = append(moves, newSquare)
+		squares, blocker := p.ray(direction)
+		moves = append(moves, squares...)
+
+		if blocker != nil && p.captures(blocker) {
+			moves = append(moves, blocker.Square())
 		}
 	}
 
 	return moves
 }
 
+// ray Get the empty squares in the given direction and the piece that stops
+// the ray (nil if the ray ends at the edge of the board).
+func (p *slidingPiece) ray(direction DirectionVec) ([]*Square, Piece) {
+	var squares []*Square
+
+	newSquare := p.square
+	for {
+		newFile := newSquare.file + direction.file
+		newRank := newSquare.rank + direction.rank
+
+		var err error
+		newSquare, err = NewSquare(newFile, newRank)
+		if err != nil {
+			// we are out of bounds -> this square doesn't exist
+			return squares, nil
+		}
+
+		// if square exists but its occupied -> the ray ends here
+		if blocker := p.board.PieceAt(newSquare); blocker != nil {
+			return squares, blocker
+		}
+
+		squares = append(squares, newSquare)
+	}
+}
+
+// PathTo Get the squares between the piece and the target if the target lies on one of its rays.
+func (p *slidingPiece) PathTo(target *Square) ([]*Square, bool) {
+	for _, direction := range p.directions {
+		if !onRay(p.square, target, direction) {
+			continue
+		}
+
+		var path []*Square
+
+		square, _ := NewSquare(p.square.file+direction.file, p.square.rank+direction.rank)
+		for square.Index() != target.Index() {
+			path = append(path, square)
+			square, _ = NewSquare(square.file+direction.file, square.rank+direction.rank)
+		}
+
+		return path, true
+	}
+
+	return nil, false
+}
+
+// onRay Checks if target can be reached by repeatedly stepping from start in the direction.
+func onRay(start, target *Square, direction DirectionVec) bool {
+	file, rank := start.file, start.rank
+
+	for {
+		file += direction.file
+		rank += direction.rank
+
+		if file < 0 || file >= FileNum || rank < 0 || rank >= RankNum {
+			return false
+		}
+
+		if file == target.file && rank == target.rank {
+			return true
+		}
+	}
+}
+
 type nonSlidingPiece struct {
 	pieceProperties
 }
@@ -130,7 +223,8 @@ func (p *nonSlidingPiece) Moves() []*Square {
 		}
 
 		// if square exists but its occupied -> move to next direction
-		if p.board.Occupied(newSquare) {
+		// (unless the piece standing there can be captured)
+		if occupant := p.board.PieceAt(newSquare); occupant != nil && !p.captures(occupant) {
 			continue
 		}
 
@@ -140,6 +234,17 @@ func (p *nonSlidingPiece) Moves() []*Square {
 	return moves
 }
 
+// PathTo Non-sliding pieces jump, so the path to a target one step away is empty.
+func (p *nonSlidingPiece) PathTo(target *Square) ([]*Square, bool) {
+	for _, direction := range p.directions {
+		if p.square.file+direction.file == target.file && p.square.rank+direction.rank == target.rank {
+			return []*Square{}, true
+		}
+	}
+
+	return nil, false
+}
+
 func NewBishop(board *Board, square *Square) *slidingPiece {
 	return &slidingPiece{
 		pieceProperties{
@@ -147,6 +252,7 @@ func NewBishop(board *Board, square *Square) *slidingPiece {
 			PieceType:  Bishop,
 			board:      board,
 			square:     square,
+			color:      White,
 		},
 	}
 }
@@ -158,6 +264,7 @@ func NewRook(board *Board, square *Square) *slidingPiece {
 			PieceType:  Rook,
 			board:      board,
 			square:     square,
+			color:      White,
 		},
 	}
 }
@@ -169,6 +276,7 @@ func NewQueen(board *Board, square *Square) *slidingPiece {
 			PieceType:  Queen,
 			board:      board,
 			square:     square,
+			color:      White,
 		},
 	}
 }
@@ -180,6 +288,7 @@ func NewKing(board *Board, square *Square) *nonSlidingPiece {
 			PieceType:  King,
 			board:      board,
 			square:     square,
+			color:      White,
 		},
 	}
 }
@@ -191,6 +300,81 @@ func NewKnight(board *Board, square *Square) *nonSlidingPiece {
 			PieceType:  Knight,
 			board:      board,
 			square:     square,
+			color:      White,
+		},
+	}
+}
+
+type pawnPiece struct {
+	pieceProperties
+}
+
+// forward Get the rank direction the pawn moves in.
+func (p *pawnPiece) forward() int {
+	if p.color == Black {
+		return -1
+	}
+	return 1
+}
+
+// startRank Checks if the pawn still stands on its starting rank.
+func (p *pawnPiece) startRank() bool {
+	if p.color == Black {
+		return p.square.rank == RankNum-2
+	}
+	return p.square.rank == 1
+}
+
+// Moves Get the squares the pawn can push to plus the squares of pieces it can capture.
+func (p *pawnPiece) Moves() []*Square {
+	var moves []*Square
+
+	if push, err := NewSquare(p.square.file, p.square.rank+p.forward()); err == nil && !p.board.Occupied(push) {
+		moves = append(moves, push)
+
+		double, err := NewSquare(p.square.file, push.rank+p.forward())
+		if err == nil && p.startRank() && !p.board.Occupied(double) {
+			moves = append(moves, double)
+		}
+	}
+
+	for _, side := range []int{-1, 1} {
+		capture, err := NewSquare(p.square.file+side, p.square.rank+p.forward())
+		if err != nil {
+			continue
+		}
+
+		if occupant := p.board.PieceAt(capture); occupant != nil && p.captures(occupant) {
+			moves = append(moves, capture)
+		}
+	}
+
+	return moves
+}
+
+// PathTo Pawns push one (or two) squares forward and capture one square diagonally forward.
+func (p *pawnPiece) PathTo(target *Square) ([]*Square, bool) {
+	files := target.file - p.square.file
+	ranks := (target.rank - p.square.rank) * p.forward()
+
+	switch {
+	case ranks == 1 && (files >= -1 && files <= 1):
+		return []*Square{}, true
+	case ranks == 2 && files == 0 && p.startRank():
+		middle, _ := NewSquare(p.square.file, p.square.rank+p.forward())
+		return []*Square{middle}, true
+	}
+
+	return nil, false
+}
+
+func NewPawn(board *Board, square *Square) *pawnPiece {
+	return &pawnPiece{
+		pieceProperties{
+			PieceType: Pawn,
+			board:     board,
+			square:    square,
+			color:     White,
 		},
 	}
 }
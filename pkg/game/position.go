@@ -0,0 +1,692 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// StartingFEN FEN of the standard starting position.
+const StartingFEN = "rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1"
+
+// Piece codes used by Position. White pieces are positive, black pieces negative.
+const (
+	noPiece int8 = iota
+	pawnCode
+	knightCode
+	bishopCode
+	rookCode
+	queenCode
+	kingCode
+)
+
+var codeTypes = [...]PieceType{"", Pawn, Knight, Bishop, Rook, Queen, King}
+
+var fenLetters = [...]byte{' ', 'p', 'n', 'b', 'r', 'q', 'k'}
+
+// Castling rights
+const (
+	whiteKingside uint8 = 1 << iota
+	whiteQueenside
+	blackKingside
+	blackQueenside
+)
+
+var castlingLetters = []struct {
+	right  uint8
+	letter byte
+}{
+	{whiteKingside, 'K'},
+	{whiteQueenside, 'Q'},
+	{blackKingside, 'k'},
+	{blackQueenside, 'q'},
+}
+
+// castlingMask castling rights kept when a piece moves from or to a square.
+var castlingMask = func() [64]uint8 {
+	var mask [64]uint8
+	for i := range mask {
+		mask[i] = whiteKingside | whiteQueenside | blackKingside | blackQueenside
+	}
+	mask[0] &^= whiteQueenside                  // a1
+	mask[7] &^= whiteKingside                   // h1
+	mask[4] &^= whiteKingside | whiteQueenside  // e1
+	mask[56] &^= blackQueenside                 // a8
+	mask[63] &^= blackKingside                  // h8
+	mask[60] &^= blackKingside | blackQueenside // e8
+	return mask
+}()
+
+// boardSquares shared squares of the board by index (squares are never changed in place).
+var boardSquares = func() [64]*Square {
+	var squares [64]*Square
+	for i := range squares {
+		squares[i] = &Square{file: i % FileNum, rank: i / FileNum}
+	}
+	return squares
+}()
+
+var (
+	knightOffsets = [][2]int{{1, 2}, {2, 1}, {2, -1}, {1, -2}, {-1, -2}, {-2, -1}, {-2, 1}, {-1, 2}}
+	kingOffsets   = [][2]int{{1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}, {0, -1}, {1, -1}}
+	rookOffsets   = [][2]int{{1, 0}, {0, 1}, {-1, 0}, {0, -1}}
+	bishopOffsets = [][2]int{{1, 1}, {-1, 1}, {-1, -1}, {1, -1}}
+)
+
+// Position A two-sided chess position: pieces of both colors, the side to move,
+// castling rights and the en passant square. Positions are never changed in
+// place, playing a move returns a new position.
+type Position struct {
+	squares    [64]int8
+	sideToMove Color
+	castling   uint8
+	// enPassant index of the square a pawn skipped with its last move or -1
+	enPassant      int
+	halfmoveClock  int
+	fullmoveNumber int
+}
+
+// positionMove A move between two square indices (promotion is a piece code).
+type positionMove struct {
+	from, to  int
+	promotion int8
+}
+
+// NewPosition Get the standard starting position.
+func NewPosition() *Position {
+	position, err := ParseFEN(StartingFEN)
+	if err != nil {
+		panic(err)
+	}
+	return position
+}
+
+// ParseFEN Create a position from Forsyth-Edwards Notation. Fails with ErrInvalidFEN.
+func ParseFEN(fen string) (*Position, error) {
+	fields := strings.Fields(fen)
+	if len(fields) < 4 {
+		return nil, fmt.Errorf("%w: expected at least 4 fields in %q", ErrInvalidFEN, fen)
+	}
+
+	position := &Position{enPassant: -1, fullmoveNumber: 1}
+
+	rows := strings.Split(fields[0], "/")
+	if len(rows) != RankNum {
+		return nil, fmt.Errorf("%w: expected %d ranks in %q", ErrInvalidFEN, RankNum, fields[0])
+	}
+
+	for row, text := range rows {
+		rank := RankNum - 1 - row
+		file := 0
+		for _, char := range text {
+			if char >= '1' && char <= '8' {
+				file += int(char - '0')
+				continue
+			}
+
+			code := int8(strings.IndexRune(string(fenLetters[:]), char|0x20))
+			if code <= noPiece || file >= FileNum {
+				return nil, fmt.Errorf("%w: bad rank %q", ErrInvalidFEN, text)
+			}
+			if char >= 'a' {
+				code = -code
+			}
+			position.squares[rank*FileNum+file] = code
+			file++
+		}
+
+		if file != FileNum {
+			return nil, fmt.Errorf("%w: bad rank %q", ErrInvalidFEN, text)
+		}
+	}
+
+	switch fields[1] {
+	case "w":
+		position.sideToMove = White
+	case "b":
+		position.sideToMove = Black
+	default:
+		return nil, fmt.Errorf("%w: bad side to move %q", ErrInvalidFEN, fields[1])
+	}
+
+	if fields[2] != "-" {
+		for _, char := range []byte(fields[2]) {
+			found := false
+			for _, castling := range castlingLetters {
+				if castling.letter == char {
+					position.castling |= castling.right
+					found = true
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("%w: bad castling rights %q", ErrInvalidFEN, fields[2])
+			}
+		}
+	}
+
+	if fields[3] != "-" {
+		square, err := NewSquareFromNotation(fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("%w: bad en passant square %q", ErrInvalidFEN, fields[3])
+		}
+		position.enPassant = square.Index()
+	}
+
+	if len(fields) >= 6 {
+		halfmove, errHalf := strconv.Atoi(fields[4])
+		fullmove, errFull := strconv.Atoi(fields[5])
+		if errHalf != nil || errFull != nil {
+			return nil, fmt.Errorf("%w: bad move counters in %q", ErrInvalidFEN, fen)
+		}
+		position.halfmoveClock = halfmove
+		position.fullmoveNumber = fullmove
+	}
+
+	if position.kingSquare(true) < 0 || position.kingSquare(false) < 0 {
+		return nil, fmt.Errorf("%w: each side needs a king", ErrInvalidFEN)
+	}
+
+	return position, nil
+}
+
+// FEN Get the position in Forsyth-Edwards Notation.
+func (p *Position) FEN() string {
+	var fen strings.Builder
+
+	for rank := RankNum - 1; rank >= 0; rank-- {
+		empty := 0
+		for file := 0; file < FileNum; file++ {
+			code := p.squares[rank*FileNum+file]
+			if code == noPiece {
+				empty++
+				continue
+			}
+			if empty > 0 {
+				fen.WriteString(strconv.Itoa(empty))
+				empty = 0
+			}
+			fen.WriteByte(codeLetter(code))
+		}
+		if empty > 0 {
+			fen.WriteString(strconv.Itoa(empty))
+		}
+		if rank > 0 {
+			fen.WriteByte('/')
+		}
+	}
+
+	side := "w"
+	if p.sideToMove == Black {
+		side = "b"
+	}
+
+	castling := ""
+	for _, right := range castlingLetters {
+		if p.castling&right.right != 0 {
+			castling += string(right.letter)
+		}
+	}
+	if castling == "" {
+		castling = "-"
+	}
+
+	enPassant := "-"
+	if p.enPassant >= 0 {
+		enPassant = boardSquares[p.enPassant].Notation()
+	}
+
+	fmt.Fprintf(&fen, " %s %s %s %d %d", side, castling, enPassant, p.halfmoveClock, p.fullmoveNumber)
+	return fen.String()
+}
+
+// codeLetter Get the FEN letter of a piece code (upper case for White).
+func codeLetter(code int8) byte {
+	if code < 0 {
+		return fenLetters[-code]
+	}
+	return fenLetters[code] - 'a' + 'A'
+}
+
+// SideToMove Get the color of the side to move.
+func (p *Position) SideToMove() Color {
+	return p.sideToMove
+}
+
+// FullmoveNumber Get the number of the current move, it starts at 1 and grows after Black's move.
+func (p *Position) FullmoveNumber() int {
+	return p.fullmoveNumber
+}
+
+// PieceAt Get the type and color of the piece on the square. Returns false if the square is empty.
+func (p *Position) PieceAt(square *Square) (PieceType, Color, bool) {
+	code := p.squares[square.Index()]
+	switch {
+	case code > noPiece:
+		return codeTypes[code], White, true
+	case code < noPiece:
+		return codeTypes[-code], Black, true
+	}
+	return "", "", false
+}
+
+// Find Get the squares of the pieces of the given type and side (ordered by index).
+func (p *Position) Find(pieceType PieceType, color Color) []*Square {
+	var squares []*Square
+	for index, code := range p.squares {
+		if code == noPiece || codeTypes[abs(code)] != pieceType || (code > noPiece) != (color == White) {
+			continue
+		}
+		squares = append(squares, boardSquares[index])
+	}
+	return squares
+}
+
+// Board Get a board with the pieces of the position (ex to render it or to ask
+// questions about it). Changing the board doesn't change the position.
+func (p *Position) Board() *Board {
+	board := NewBoard()
+	for index, code := range p.squares {
+		if code == noPiece {
+			continue
+		}
+
+		color := White
+		if code < noPiece {
+			color = Black
+		}
+		board.AddColoredPiece(codeTypes[abs(code)], color, boardSquares[index])
+	}
+	return board
+}
+
+// LegalMoves Get all legal moves of the side to move.
+func (p *Position) LegalMoves() []Move {
+	var moves []Move
+	for _, move := range p.legalMoves() {
+		moves = append(moves, p.exportMove(move))
+	}
+	return moves
+}
+
+// exportMove Convert an internal move to a Move.
+func (p *Position) exportMove(move positionMove) Move {
+	exported := Move{
+		Piece: codeTypes[abs(p.squares[move.from])],
+		From:  boardSquares[move.from],
+		To:    boardSquares[move.to],
+	}
+	if move.promotion != noPiece {
+		exported.Promotion = codeTypes[move.promotion]
+	}
+	return exported
+}
+
+// Play Play a legal move and get the resulting position. A pawn reaching the
+// last rank becomes a Queen unless the move names another promotion piece.
+// Fails with ErrIllegalMove.
+func (p *Position) Play(move Move) (*Position, error) {
+	if move.From == nil || move.To == nil {
+		return nil, fmt.Errorf("%w: move needs a start and a target square", ErrIllegalMove)
+	}
+
+	promotion := move.Promotion
+	if promotion == "" {
+		promotion = Queen
+	}
+
+	white := p.sideToMove == White
+	for _, pseudo := range p.pseudoLegalMoves() {
+		if pseudo.from != move.From.Index() || pseudo.to != move.To.Index() {
+			continue
+		}
+		if pseudo.promotion != noPiece && codeTypes[pseudo.promotion] != promotion {
+			continue
+		}
+
+		next := p.play(pseudo)
+		if next.attacked(next.kingSquare(white), !white) {
+			break
+		}
+		return &next, nil
+	}
+
+	return nil, fmt.Errorf("%w: %s-%s", ErrIllegalMove, move.From.Notation(), move.To.Notation())
+}
+
+// ParseUCI Finds the legal move written in the notation of the Universal Chess
+// Interface (ex "g1f3", "e7e8q"). Fails with ErrInvalidSAN or ErrIllegalMove.
+func (p *Position) ParseUCI(text string) (Move, error) {
+	text = strings.TrimSpace(text)
+	if len(text) != 4 && len(text) != 5 {
+		return Move{}, fmt.Errorf("%w: %q is not a UCI move", ErrInvalidSAN, text)
+	}
+
+	from, errFrom := NewSquareFromNotation(text[:2])
+	to, errTo := NewSquareFromNotation(text[2:4])
+	if errFrom != nil || errTo != nil {
+		return Move{}, fmt.Errorf("%w: %q is not a UCI move", ErrInvalidSAN, text)
+	}
+
+	promotion := PieceType("")
+	if len(text) == 5 {
+		var found bool
+		if promotion, found = promotionPiece(strings.ToUpper(text[4:])); !found {
+			return Move{}, fmt.Errorf("%w: bad promotion piece in %q", ErrInvalidSAN, text)
+		}
+	}
+
+	for _, move := range p.LegalMoves() {
+		if move.From.Index() == from.Index() && move.To.Index() == to.Index() && move.Promotion == promotion {
+			return move, nil
+		}
+	}
+
+	return Move{}, fmt.Errorf("%w: %s", ErrIllegalMove, text)
+}
+
+// InCheck Checks if the king of the side to move is attacked.
+func (p *Position) InCheck() bool {
+	white := p.sideToMove == White
+	return p.attacked(p.kingSquare(white), !white)
+}
+
+// Checkmate Checks if the side to move is in check and has no legal moves.
+func (p *Position) Checkmate() bool {
+	return p.InCheck() && len(p.legalMoves()) == 0
+}
+
+// Stalemate Checks if the side to move is not in check but has no legal moves.
+func (p *Position) Stalemate() bool {
+	return !p.InCheck() && len(p.legalMoves()) == 0
+}
+
+// Perft Count the leaf nodes of the legal move tree of the given depth (used
+// to verify move generation against known counts).
+func (p *Position) Perft(depth int) int {
+	if depth == 0 {
+		return 1
+	}
+
+	moves := p.legalMoves()
+	if depth == 1 {
+		return len(moves)
+	}
+
+	nodes := 0
+	for _, move := range moves {
+		next := p.play(move)
+		nodes += next.Perft(depth - 1)
+	}
+	return nodes
+}
+
+// legalMoves Get the pseudo-legal moves that don't leave the own king in check.
+func (p *Position) legalMoves() []positionMove {
+	white := p.sideToMove == White
+	pseudo := p.pseudoLegalMoves()
+	legal := pseudo[:0]
+
+	for _, move := range pseudo {
+		next := p.play(move)
+		if !next.attacked(next.kingSquare(white), !white) {
+			legal = append(legal, move)
+		}
+	}
+	return legal
+}
+
+// pseudoLegalMoves Get the moves of the side to move ignoring checks to the own king.
+func (p *Position) pseudoLegalMoves() []positionMove {
+	moves := make([]positionMove, 0, 48)
+	white := p.sideToMove == White
+
+	for from, code := range p.squares {
+		if code == noPiece || (code > noPiece) != white {
+			continue
+		}
+
+		switch abs(code) {
+		case pawnCode:
+			moves = p.pawnMoves(moves, from, white)
+		case knightCode:
+			moves = p.leaperMoves(moves, from, white, knightOffsets)
+		case bishopCode:
+			moves = p.riderMoves(moves, from, white, bishopOffsets)
+		case rookCode:
+			moves = p.riderMoves(moves, from, white, rookOffsets)
+		case queenCode:
+			moves = p.riderMoves(moves, from, white, rookOffsets)
+			moves = p.riderMoves(moves, from, white, bishopOffsets)
+		case kingCode:
+			moves = p.leaperMoves(moves, from, white, kingOffsets)
+			moves = p.castlingMoves(moves, from, white)
+		}
+	}
+
+	return moves
+}
+
+// target Get the index of the square at the offset or -1 if it is off the board.
+func target(from int, offset [2]int) int {
+	file := from%FileNum + offset[0]
+	rank := from/FileNum + offset[1]
+	if file < 0 || file >= FileNum || rank < 0 || rank >= RankNum {
+		return -1
+	}
+	return rank*FileNum + file
+}
+
+// enemy Checks if the piece code belongs to the opponent of the given side.
+func enemy(code int8, white bool) bool {
+	return code != noPiece && (code > noPiece) != white
+}
+
+func (p *Position) leaperMoves(moves []positionMove, from int, white bool, offsets [][2]int) []positionMove {
+	for _, offset := range offsets {
+		to := target(from, offset)
+		if to >= 0 && (p.squares[to] == noPiece || enemy(p.squares[to], white)) {
+			moves = append(moves, positionMove{from: from, to: to})
+		}
+	}
+	return moves
+}
+
+func (p *Position) riderMoves(moves []positionMove, from int, white bool, offsets [][2]int) []positionMove {
+	for _, offset := range offsets {
+		for to := target(from, offset); to >= 0; to = target(to, offset) {
+			if p.squares[to] != noPiece {
+				if enemy(p.squares[to], white) {
+					moves = append(moves, positionMove{from: from, to: to})
+				}
+				break
+			}
+			moves = append(moves, positionMove{from: from, to: to})
+		}
+	}
+	return moves
+}
+
+func (p *Position) pawnMoves(moves []positionMove, from int, white bool) []positionMove {
+	forward, startRank, lastRank := 1, 1, RankNum-1
+	if !white {
+		forward, startRank, lastRank = -1, RankNum-2, 0
+	}
+
+	add := func(to int) {
+		if to/FileNum != lastRank {
+			moves = append(moves, positionMove{from: from, to: to})
+			return
+		}
+		for _, promotion := range []int8{queenCode, rookCode, bishopCode, knightCode} {
+			moves = append(moves, positionMove{from: from, to: to, promotion: promotion})
+		}
+	}
+
+	if to := target(from, [2]int{0, forward}); to >= 0 && p.squares[to] == noPiece {
+		add(to)
+		if double := target(to, [2]int{0, forward}); from/FileNum == startRank && p.squares[double] == noPiece {
+			add(double)
+		}
+	}
+
+	for _, side := range []int{-1, 1} {
+		to := target(from, [2]int{side, forward})
+		if to >= 0 && (enemy(p.squares[to], white) || to == p.enPassant) {
+			add(to)
+		}
+	}
+
+	return moves
+}
+
+func (p *Position) castlingMoves(moves []positionMove, from int, white bool) []positionMove {
+	kingside, queenside, home := whiteKingside, whiteQueenside, 4
+	if !white {
+		kingside, queenside, home = blackKingside, blackQueenside, 60
+	}
+	if from != home || p.attacked(home, !white) {
+		return moves
+	}
+
+	if p.castling&kingside != 0 &&
+		p.squares[home+1] == noPiece && p.squares[home+2] == noPiece &&
+		!p.attacked(home+1, !white) && !p.attacked(home+2, !white) {
+		moves = append(moves, positionMove{from: home, to: home + 2})
+	}
+
+	if p.castling&queenside != 0 &&
+		p.squares[home-1] == noPiece && p.squares[home-2] == noPiece && p.squares[home-3] == noPiece &&
+		!p.attacked(home-1, !white) && !p.attacked(home-2, !white) {
+		moves = append(moves, positionMove{from: home, to: home - 2})
+	}
+
+	return moves
+}
+
+// attacked Checks if the square is attacked by a piece of the given side.
+func (p *Position) attacked(square int, byWhite bool) bool {
+	sign := int8(1)
+	pawnRank := -1 // attacking pawns stand one rank below the square
+	if !byWhite {
+		sign, pawnRank = -1, 1
+	}
+
+	for _, side := range []int{-1, 1} {
+		if from := target(square, [2]int{side, pawnRank}); from >= 0 && p.squares[from] == sign*pawnCode {
+			return true
+		}
+	}
+
+	for _, offset := range knightOffsets {
+		if from := target(square, offset); from >= 0 && p.squares[from] == sign*knightCode {
+			return true
+		}
+	}
+
+	for _, offset := range kingOffsets {
+		if from := target(square, offset); from >= 0 && p.squares[from] == sign*kingCode {
+			return true
+		}
+	}
+
+	sliders := []struct {
+		offsets [][2]int
+		piece   int8
+	}{
+		{rookOffsets, rookCode},
+		{bishopOffsets, bishopCode},
+	}
+	for _, slider := range sliders {
+		for _, offset := range slider.offsets {
+			for from := target(square, offset); from >= 0; from = target(from, offset) {
+				code := p.squares[from]
+				if code == noPiece {
+					continue
+				}
+				if code == sign*slider.piece || code == sign*queenCode {
+					return true
+				}
+				break
+			}
+		}
+	}
+
+	return false
+}
+
+// kingSquare Get the index of the king of the given side or -1 if there is no king.
+func (p *Position) kingSquare(white bool) int {
+	king := kingCode
+	if !white {
+		king = -kingCode
+	}
+	for square, code := range p.squares {
+		if code == king {
+			return square
+		}
+	}
+	return -1
+}
+
+// play Play a pseudo-legal move and get the resulting position.
+func (p *Position) play(move positionMove) Position {
+	next := *p
+	piece := next.squares[move.from]
+	captured := next.squares[move.to]
+
+	next.squares[move.from] = noPiece
+	next.squares[move.to] = piece
+	next.enPassant = -1
+	next.halfmoveClock++
+
+	switch abs(piece) {
+	case pawnCode:
+		next.halfmoveClock = 0
+		distance := move.to - move.from
+		switch {
+		case distance == 2*FileNum || distance == -2*FileNum:
+			next.enPassant = move.from + distance/2
+		case move.to == p.enPassant && captured == noPiece:
+			// the captured pawn stands beside the moving pawn
+			next.squares[move.from/FileNum*FileNum+move.to%FileNum] = noPiece
+		}
+		if move.promotion != noPiece {
+			next.squares[move.to] = move.promotion
+			if piece < noPiece {
+				next.squares[move.to] = -move.promotion
+			}
+		}
+	case kingCode:
+		// castling also moves the rook
+		switch move.to - move.from {
+		case 2:
+			next.squares[move.from+1] = next.squares[move.from+3]
+			next.squares[move.from+3] = noPiece
+		case -2:
+			next.squares[move.from-1] = next.squares[move.from-4]
+			next.squares[move.from-4] = noPiece
+		}
+	}
+
+	if captured != noPiece {
+		next.halfmoveClock = 0
+	}
+
+	next.castling &= castlingMask[move.from] & castlingMask[move.to]
+
+	if p.sideToMove == White {
+		next.sideToMove = Black
+	} else {
+		next.sideToMove = White
+		next.fullmoveNumber++
+	}
+
+	return next
+}
+
+func abs(code int8) int8 {
+	if code < 0 {
+		return -code
+	}
+	return code
+}
@@ -0,0 +1,256 @@
+package game
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestPositionPerft(t *testing.T) {
+	tests := []struct {
+		name  string
+		fen   string
+		nodes []int // expected nodes for depth 1, 2, ...
+	}{
+		{"start", StartingFEN, []int{20, 400, 8902, 197281}},
+		{"kiwipete", "r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1", []int{48, 2039, 97862}},
+		{"endgame", "8/2p5/3p4/KP5r/1R3p1k/8/4P1P1/8 w - - 0 1", []int{14, 191, 2812, 43238}},
+		{"promotions", "r3k2r/Pppp1ppp/1b3nbN/nP6/BBP1P3/q4N2/Pp1P2PP/R2Q1RK1 w kq - 0 1", []int{6, 264, 9467}},
+		{"middlegame", "rnbq1k1r/pp1Pbppp/2p5/8/2B5/8/PPP1NnPP/RNBQK2R w KQ - 1 8", []int{44, 1486, 62379}},
+	}
+
+	for _, test := range tests {
+		position, err := ParseFEN(test.fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for depth, expected := range test.nodes {
+			if testing.Short() && expected > 10000 {
+				break
+			}
+			if nodes := position.Perft(depth + 1); nodes != expected {
+				t.Errorf("%s: expected perft(%d) = %d but got %d", test.name, depth+1, expected, nodes)
+			}
+		}
+	}
+}
+
+func TestPositionFEN(t *testing.T) {
+	fens := []string{
+		StartingFEN,
+		"r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1",
+		"rnbqkbnr/pppp1ppp/8/4p3/4P3/8/PPPP1PPP/RNBQKBNR w KQkq e6 0 2",
+	}
+
+	for _, fen := range fens {
+		position, err := ParseFEN(fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if position.FEN() != fen {
+			t.Errorf("expected FEN %s but got %s", fen, position.FEN())
+		}
+	}
+
+	invalid := []string{
+		"",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP w KQkq - 0 1",
+		"rnbqkbnr/pppppppp/9/8/8/8/PPPPPPPP/RNBQKBNR w KQkq - 0 1",
+		"rnbqkbnr/pppppppp/8/8/8/8/PPPPPPPP/RNBQKBNR x KQkq - 0 1",
+		"8/8/8/8/8/8/8/8 w - - 0 1",
+	}
+	for _, fen := range invalid {
+		if _, err := ParseFEN(fen); !errors.Is(err, ErrInvalidFEN) {
+			t.Errorf("expected ErrInvalidFEN for %q but got %v", fen, err)
+		}
+	}
+}
+
+func TestPositionPlay(t *testing.T) {
+	square := func(notation string) *Square {
+		sq, _ := NewSquareFromNotation(notation)
+		return sq
+	}
+
+	position := NewPosition()
+	for _, move := range [][2]string{{"e2", "e4"}, {"d7", "d5"}, {"e4", "e5"}, {"f7", "f5"}} {
+		next, err := position.Play(Move{From: square(move[0]), To: square(move[1])})
+		if err != nil {
+			t.Fatal(err)
+		}
+		position = next
+	}
+
+	// en passant
+	position, err := position.Play(Move{From: square("e5"), To: square("f6")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, found := position.PieceAt(square("f5")); found {
+		t.Errorf("expected en passant to capture the pawn on f5")
+	}
+
+	if _, err := position.Play(Move{From: square("e8"), To: square("e6")}); !errors.Is(err, ErrIllegalMove) {
+		t.Errorf("expected ErrIllegalMove but got %v", err)
+	}
+
+	// castling and under-promotion
+	position, _ = ParseFEN("4k3/1P6/8/8/8/8/8/R3K2R w KQ - 0 1")
+	castled, err := position.Play(Move{From: square("e1"), To: square("c1")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pieceType, _, _ := castled.PieceAt(square("d1")); pieceType != Rook {
+		t.Errorf("expected the rook to move to d1 when castling queenside")
+	}
+
+	promoted, err := position.Play(Move{From: square("b7"), To: square("b8"), Promotion: Knight})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pieceType, color, _ := promoted.PieceAt(square("b8")); pieceType != Knight || color != White {
+		t.Errorf("expected a white knight on b8 but got %s %s", color, pieceType)
+	}
+}
+
+func TestPositionCheckmateStalemate(t *testing.T) {
+	tests := []struct {
+		fen                         string
+		check, checkmate, stalemate bool
+	}{
+		{StartingFEN, false, false, false},
+		// fool's mate
+		{"rnb1kbnr/pppp1ppp/8/4p3/6Pq/5P2/PPPPP2P/RNBQKBNR w KQkq - 1 3", true, true, false},
+		{"7k/5Q2/6K1/8/8/8/8/8 b - - 0 1", false, false, true},
+		{"4k3/8/8/8/8/8/8/4K2r w - - 0 1", true, false, false},
+	}
+
+	for _, test := range tests {
+		position, err := ParseFEN(test.fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if position.InCheck() != test.check || position.Checkmate() != test.checkmate || position.Stalemate() != test.stalemate {
+			t.Errorf(
+				"%s: expected check=%t checkmate=%t stalemate=%t",
+				test.fen,
+				test.check,
+				test.checkmate,
+				test.stalemate,
+			)
+		}
+	}
+}
+
+func TestPositionBoard(t *testing.T) {
+	position, err := ParseFEN("4k3/8/8/3p4/4P3/2n5/8/4K1N1 w - - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	board := position.Board()
+	expected := `8 . . . . k . . .
+7 . . . . . . . .
+6 . . . . . . . .
+5 . . . p . . . .
+4 . . . . P . . .
+3 . . n . . . . .
+2 . . . . . . . .
+1 . . . . K . N .
+  a b c d e f g h
+`
+	if rendered := board.Render(DefaultRenderOptions()); rendered != expected {
+		t.Errorf("expected board\n%s\nbut got\n%s", expected, rendered)
+	}
+
+	square := func(notation string) *Square {
+		sq, _ := NewSquareFromNotation(notation)
+		return sq
+	}
+
+	// pieces capture pieces of the other side but are blocked by their own
+	pawn := board.PieceAt(square("e4"))
+	if pawn.Color() != White || !reaches(pawn, square("d5")) || !reaches(pawn, square("e5")) {
+		t.Errorf("expected the pawn to push to e5 and capture on d5")
+	}
+
+	knight := board.PieceAt(square("c3"))
+	if knight.Color() != Black || !reaches(knight, square("e4")) || reaches(knight, square("d5")) {
+		t.Errorf("expected the black knight to capture on e4 but not on d5")
+	}
+
+	if err := board.MovePiece(pawn, square("d5")); err != nil {
+		t.Fatal(err)
+	}
+	if captured := board.PieceAt(square("d5")); captured != pawn || len(board.pieces) != 5 {
+		t.Errorf("expected the pawn to capture on d5")
+	}
+}
+
+func TestPositionQuestions(t *testing.T) {
+	board := NewPosition().Board()
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 20; i++ {
+		question, ok := NewUniqueLocateQuestion(board, rng)
+		if !ok {
+			t.Fatalf("expected a locate question")
+		}
+		if question.Piece.Type() == Pawn || question.Piece.Type() == Knight || !board.Unique(question.Piece) {
+			t.Errorf("expected a question about a unique piece but got %s", question.Piece.Type())
+		}
+		if !question.CheckSquare(board, question.Square) {
+			t.Errorf("expected %s to answer the question", question.Square.Notation())
+		}
+
+		reach, ok := NewReachQuestion(board, rng)
+		if !ok {
+			t.Fatalf("expected a reach question")
+		}
+		if !reach.CheckPiece(reach.Piece) || !reaches(reach.Piece, reach.Square) {
+			t.Errorf("expected %s to reach %s", reach.Piece.Type(), reach.Square.Notation())
+		}
+	}
+}
+
+func TestPositionUCI(t *testing.T) {
+	position, _ := ParseFEN("4k3/1P6/8/8/8/8/8/4K1N1 w - - 0 1")
+
+	for _, text := range []string{"g1f3", "b7b8n", "e1d2"} {
+		move, err := position.ParseUCI(text)
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", text, err)
+			continue
+		}
+		if move.UCI() != text {
+			t.Errorf("expected %s but got %s", text, move.UCI())
+		}
+	}
+
+	for _, text := range []string{"g1g3", "b7b8", "e1e2e3", "z1f3", "b7b8x"} {
+		if _, err := position.ParseUCI(text); err == nil {
+			t.Errorf("expected an error for %s", text)
+		}
+	}
+}
+
+func TestPositionFullmoveNumber(t *testing.T) {
+	position, err := ParseFEN("4k3/8/8/8/8/8/8/4K3 b - - 0 12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if position.FullmoveNumber() != 12 {
+		t.Errorf("expected move 12 but got %d", position.FullmoveNumber())
+	}
+
+	e8, _ := NewSquareFromNotation("e8")
+	d8, _ := NewSquareFromNotation("d8")
+	next, err := position.Play(Move{From: e8, To: d8})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next.FullmoveNumber() != 13 || next.SideToMove() != White {
+		t.Errorf("expected White's move 13 after Black moved but got %d for %s", next.FullmoveNumber(), next.SideToMove())
+	}
+}
@@ -0,0 +1,101 @@
+package game
+
+import (
+	"math/rand"
+)
+
+type QuestionKind int
+
+const (
+	// ReachQuestion "Which piece can go to <square>?" answered with a piece type.
+	ReachQuestion QuestionKind = iota
+	// LocateQuestion "Where is the <piece>?" answered with a square.
+	LocateQuestion
+)
+
+// Question A question about the current board position.
+type Question struct {
+	Kind QuestionKind
+	// Piece the piece the question is about
+	Piece Piece
+	// Square the square to reach (ReachQuestion) or where the piece stands (LocateQuestion)
+	Square *Square
+}
+
+// NewReachQuestion Chooses a singular square and the piece that can reach it.
+// Returns false if no square can be reached by exactly one piece.
+func NewReachQuestion(board *Board, rng *rand.Rand) (Question, bool) {
+	squares := board.SingularSquares()
+	if len(squares) == 0 {
+		return Question{}, false
+	}
+
+	square := squares[rng.Intn(len(squares))]
+
+	return Question{
+		Kind:   ReachQuestion,
+		Piece:  board.PieceThatReachesSquare(square),
+		Square: square,
+	}, true
+}
+
+// NewLocateQuestion Chooses a random piece to ask the location of.
+// Returns false if the board is empty.
+func NewLocateQuestion(board *Board, rng *rand.Rand) (Question, bool) {
+	if len(board.pieces) == 0 {
+		return Question{}, false
+	}
+
+	piece := board.pieces[rng.Intn(len(board.pieces))]
+
+	return Question{
+		Kind:   LocateQuestion,
+		Piece:  piece,
+		Square: piece.Square(),
+	}, true
+}
+
+// NewUniqueLocateQuestion Chooses a random piece that is the only one of its type
+// and side (ex the black queen but not one of the pawns) to ask the location of.
+// Returns false if there is no such piece.
+func NewUniqueLocateQuestion(board *Board, rng *rand.Rand) (Question, bool) {
+	var unique []Piece
+	for _, piece := range board.pieces {
+		if board.Unique(piece) {
+			unique = append(unique, piece)
+		}
+	}
+
+	if len(unique) == 0 {
+		return Question{}, false
+	}
+
+	piece := unique[rng.Intn(len(unique))]
+
+	return Question{
+		Kind:   LocateQuestion,
+		Piece:  piece,
+		Square: piece.Square(),
+	}, true
+}
+
+// Check Checks if the given piece type answers a ReachQuestion. With more than one
+// piece of the type on the board use CheckPiece instead.
+func (q Question) Check(piece PieceType) bool {
+	return q.Kind == ReachQuestion && piece == q.Piece.Type()
+}
+
+// CheckPiece Checks if the given piece answers a ReachQuestion.
+func (q Question) CheckPiece(piece Piece) bool {
+	return q.Kind == ReachQuestion && piece != nil && piece.ID() == q.Piece.ID()
+}
+
+// CheckSquare Checks if the given square answers a LocateQuestion.
+func (q Question) CheckSquare(board *Board, square *Square) bool {
+	if q.Kind != LocateQuestion {
+		return false
+	}
+
+	piece := board.PieceAt(square)
+	return piece != nil && piece.ID() == q.Piece.ID()
+}
@@ -0,0 +1,157 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RenderStyle int
+
+const (
+	// ASCIIStyle draws pieces with their letters (ex "N" for Knight).
+	ASCIIStyle RenderStyle = iota
+	// UnicodeStyle draws pieces as figurines (ex "♘" for Knight).
+	UnicodeStyle
+)
+
+const (
+	ansiReset       = "\033[0m"
+	ansiLightSquare = "\033[30;48;5;223m"
+	ansiDarkSquare  = "\033[30;48;5;137m"
+)
+
+var pieceFigurines = map[PieceType]string{
+	Bishop: "♗",
+	Knight: "♘",
+	Rook:   "♖",
+	King:   "♔",
+	Queen:  "♕",
+	Pawn:   "♙",
+}
+
+var blackFigurines = map[PieceType]string{
+	Bishop: "♝",
+	Knight: "♞",
+	Rook:   "♜",
+	King:   "♚",
+	Queen:  "♛",
+	Pawn:   "♟",
+}
+
+// RenderOptions Settings for drawing a board.
+type RenderOptions struct {
+	Style RenderStyle
+	// Colored draws squares with their colour (see Square.Color) using ANSI escape codes.
+	Colored bool
+	// Orientation side the board is viewed from (White has rank 1 at the bottom).
+	Orientation Color
+	// Language letters of the pieces in ASCIIStyle, the zero value means English
+	Language Language
+}
+
+func DefaultRenderOptions() RenderOptions {
+	return RenderOptions{
+		Style:       ASCIIStyle,
+		Colored:     false,
+		Orientation: White,
+		Language:    English,
+	}
+}
+
+// symbol Get the symbol of a piece in the given style (black pieces are drawn
+// with lower case letters or filled figurines).
+func symbol(piece Piece, style RenderStyle, language Language) string {
+	black := piece.Color() == Black
+
+	// pieces without a figurine (ex fairy pieces) are shown by their letter
+	if _, found := pieceFigurines[piece.Type()]; found && style == UnicodeStyle {
+		if black {
+			return blackFigurines[piece.Type()]
+		}
+		return pieceFigurines[piece.Type()]
+	}
+
+	if language.Code == "" {
+		language = English
+	}
+
+	letter := language.Letter(piece.Type())
+	if piece.Type() == Pawn {
+		letter = language.pawnLetter
+	}
+	if black {
+		return strings.ToLower(letter)
+	}
+	return letter
+}
+
+// emptySymbol Get the symbol of an empty square in the given style.
+func emptySymbol(style RenderStyle) string {
+	if style == UnicodeStyle {
+		return "·"
+	}
+	return "."
+}
+
+// Render Draws the board as text with rank numbers on the left and files at the bottom.
+func (b *Board) Render(options RenderOptions) string {
+	var out strings.Builder
+	options.Language = b.language(options.Language)
+
+	files := make([]int, FileNum)
+	ranks := make([]int, RankNum)
+	for i := range files {
+		files[i] = i
+		ranks[i] = RankNum - 1 - i // top row is the last rank
+	}
+
+	if options.Orientation == Black {
+		for i := range files {
+			files[i] = FileNum - 1 - i
+			ranks[i] = i
+		}
+	}
+
+	for _, rank := range ranks {
+		out.WriteString(fmt.Sprint(Ranks[rank]))
+		if options.Colored {
+			out.WriteString(" ")
+		}
+
+		for _, file := range files {
+			square, _ := NewSquare(file, rank)
+
+			cell := emptySymbol(options.Style)
+			if piece := b.PieceAt(square); piece != nil {
+				cell = symbol(piece, options.Style, options.Language)
+			}
+
+			if !options.Colored {
+				out.WriteString(" " + cell)
+				continue
+			}
+
+			squareColor := ansiLightSquare
+			if square.Color() == Black {
+				squareColor = ansiDarkSquare
+			}
+			out.WriteString(squareColor + " " + cell + " " + ansiReset)
+		}
+		out.WriteString("\n")
+	}
+
+	fileLabels := " "
+	if options.Colored {
+		fileLabels += " "
+	}
+	for _, file := range files {
+		if options.Colored {
+			fileLabels += " " + Files[file] + " "
+		} else {
+			fileLabels += " " + Files[file]
+		}
+	}
+	out.WriteString(strings.TrimRight(fileLabels, " ") + "\n")
+
+	return out.String()
+}
@@ -0,0 +1,63 @@
+package game
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+func renderTestBoard() *Board {
+	board := NewBoard()
+
+	for notation, pieceType := range map[string]PieceType{
+		"a1": Rook,
+		"b1": Knight,
+		"c4": Bishop,
+		"e1": King,
+		"d8": Queen,
+	} {
+		square, _ := NewSquareFromNotation(notation)
+		board.AddPiece(pieceType, square)
+	}
+
+	return board
+}
+
+func TestBoardRender(t *testing.T) {
+	board := renderTestBoard()
+
+	tests := []struct {
+		golden  string
+		options RenderOptions
+	}{
+		{"render_ascii_white.golden", RenderOptions{Style: ASCIIStyle, Orientation: White}},
+		{"render_ascii_black.golden", RenderOptions{Style: ASCIIStyle, Orientation: Black}},
+		{"render_unicode_white.golden", RenderOptions{Style: UnicodeStyle, Orientation: White}},
+		{"render_unicode_color.golden", RenderOptions{Style: UnicodeStyle, Colored: true, Orientation: White}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.golden, func(t *testing.T) {
+			rendered := board.Render(test.options)
+			path := filepath.Join("testdata", test.golden)
+
+			if *update {
+				if err := os.WriteFile(path, []byte(rendered), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			expected, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if rendered != string(expected) {
+				t.Errorf("rendered board differs from %s:\n%s\nexpected:\n%s", path, rendered, expected)
+			}
+		})
+	}
+}
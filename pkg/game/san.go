@@ -0,0 +1,485 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SAN Get the move in standard algebraic notation (ex "Nbd2", "Rxe5").
+// The board must be the position before the move is made.
+func (m Move) SAN(board *Board) string {
+	var san strings.Builder
+	language := board.language(English)
+
+	if m.Piece == Pawn {
+		// pawn captures start with the origin file (ex "exd5")
+		if board.Occupied(m.To) || m.From.file != m.To.file {
+			san.WriteString(m.From.Notation()[:1] + "x")
+		}
+		san.WriteString(m.To.Notation())
+		if m.Promotion != "" {
+			san.WriteString("=" + language.Letter(m.Promotion))
+		}
+		return san.String()
+	}
+
+	san.WriteString(language.Letter(m.Piece))
+	san.WriteString(m.disambiguation(board))
+
+	if board.Occupied(m.To) {
+		san.WriteString("x")
+	}
+
+	san.WriteString(m.To.Notation())
+	return san.String()
+}
+
+// disambiguation Get the part of the origin square needed to tell the move apart
+// from moves of other pieces of the same type to the same square.
+func (m Move) disambiguation(board *Board) string {
+	sameFile, sameRank, others := false, false, false
+
+	for _, piece := range board.pieces {
+		if piece.Type() != m.Piece || piece.Square().Index() == m.From.Index() {
+			continue
+		}
+
+		if !reaches(piece, m.To) {
+			continue
+		}
+
+		others = true
+		sameFile = sameFile || piece.Square().file == m.From.file
+		sameRank = sameRank || piece.Square().rank == m.From.rank
+	}
+
+	notation := m.From.Notation()
+	switch {
+	case !others:
+		return ""
+	case !sameFile:
+		return notation[:1]
+	case !sameRank:
+		return notation[1:]
+	default:
+		return notation
+	}
+}
+
+// reaches Checks if the piece can move to the square.
+func reaches(piece Piece, square *Square) bool {
+	for _, move := range piece.Moves() {
+		if move.Index() == square.Index() {
+			return true
+		}
+	}
+	return false
+}
+
+// sanMove Parts of a move written in standard algebraic notation.
+type sanMove struct {
+	piece PieceType
+	// fromFile, fromRank parts of the origin square (-1 if not given)
+	fromFile int
+	fromRank int
+	capture  bool
+	to       *Square
+	// promotion piece a pawn becomes (empty if not given)
+	promotion PieceType
+}
+
+// figurines Figurine algebraic notation (ex "♘f3"), a language of its own for
+// the SAN parser and renderer.
+var figurines = Language{
+	Code: "figurine",
+	Name: "Figurine",
+	letters: map[PieceType]string{
+		King:   pieceFigurines[King],
+		Queen:  pieceFigurines[Queen],
+		Rook:   pieceFigurines[Rook],
+		Bishop: pieceFigurines[Bishop],
+		Knight: pieceFigurines[Knight],
+	},
+}
+
+// iccfPromotions pieces by the digit that ends a promotion in ICCF numeric notation.
+var iccfPromotions = []PieceType{Queen, Rook, Bishop, Knight}
+
+// normalizeSAN Cleans up a move typed by a player: removes whitespace, turns
+// spoken ranks into digits ("N f three"), figurines into piece letters of the
+// language and fixes the case ("nF3" -> "Nf3", "E4" -> "e4").
+func normalizeSAN(text string, language Language) string {
+	san := strings.ReplaceAll(speakDigits(text), " ", "")
+
+	for pieceType, figurine := range figurines.letters {
+		san = strings.ReplaceAll(san, figurine, language.Letter(pieceType))
+		san = strings.ReplaceAll(san, blackFigurines[pieceType], language.Letter(pieceType))
+	}
+	// pawns have no letter ("♙e4" is "e4")
+	san = strings.ReplaceAll(san, pieceFigurines[Pawn], "")
+	san = strings.ReplaceAll(san, blackFigurines[Pawn], "")
+
+	if san == "" {
+		return san
+	}
+
+	// a lower case piece letter is only a piece if it isn't a file as well ("b4" is a pawn move)
+	first := san[:1]
+	_, file := Contains(Files, strings.ToLower(first))
+	_, piece := language.pieceType(strings.ToUpper(first))
+
+	switch {
+	case piece && (!file || first == strings.ToUpper(first)):
+		first = strings.ToUpper(first)
+	case file:
+		first = strings.ToLower(first)
+	}
+
+	return first + san[1:]
+}
+
+// parseICCF Splits a move in ICCF numeric notation ("7163", "57581" for e7-e8=Q) into its parts.
+func parseICCF(text string) (sanMove, bool) {
+	move := sanMove{fromFile: -1, fromRank: -1}
+	if len(text) != 4 && len(text) != 5 {
+		return move, false
+	}
+
+	from, err := ParseSquare(text[:2])
+	if err != nil {
+		return move, false
+	}
+	to, err := ParseSquare(text[2:4])
+	if err != nil {
+		return move, false
+	}
+
+	move.fromFile, move.fromRank, move.to = from.file, from.rank, to
+	if len(text) == 5 {
+		digit := int(text[4] - '1')
+		if digit < 0 || digit >= len(iccfPromotions) {
+			return move, false
+		}
+		move.promotion = iccfPromotions[digit]
+	}
+	return move, true
+}
+
+// parseSANText Splits a move like "Nbd2", "Rxe5", "Ng1-f3" or (pawn moves) "e4",
+// "exd5", "e8=Q" into its parts.
+func parseSANText(text string) (sanMove, error) {
+	return parseLocalSANText(text, English)
+}
+
+// parseLocalSANText Splits a move written with the piece letters of the language
+// (or figurines) into its parts. Moves in ICCF numeric notation are accepted as
+// well, their piece is left empty.
+func parseLocalSANText(text string, language Language) (sanMove, error) {
+	move := sanMove{fromFile: -1, fromRank: -1}
+
+	san := strings.TrimRight(normalizeSAN(text, language), "+#!?")
+	if iccf, ok := parseICCF(san); ok {
+		return iccf, nil
+	}
+
+	if len(san) < 2 {
+		return move, fmt.Errorf("%w: %q is too short", ErrInvalidSAN, text)
+	}
+
+	letter := san[:1]
+	if pieceType, found := language.pieceType(letter); found {
+		move.piece = pieceType
+	}
+
+	if move.piece == "" {
+		if _, file := Contains(Files, letter); !file {
+			return move, fmt.Errorf("%w: %q doesn't start with a piece letter", ErrInvalidSAN, text)
+		}
+
+		// pawn moves have no piece letter but may end with a promotion piece ("e8=Q")
+		move.piece = Pawn
+		if promotion, found := language.promotionPiece(strings.ToUpper(san[len(san)-1:])); found && len(san) > 2 {
+			move.promotion = promotion
+			san = strings.TrimSuffix(san[:len(san)-1], "=")
+		}
+		san = "P" + san
+	}
+
+	if len(san) < 3 {
+		return move, fmt.Errorf("%w: %q is too short", ErrInvalidSAN, text)
+	}
+
+	to, err := NewSquareFromNotation(strings.ToLower(san[len(san)-2:]))
+	if err != nil {
+		return move, fmt.Errorf("%w: %q doesn't end with a square", ErrInvalidSAN, text)
+	}
+	move.to = to
+
+	// whatever is between the piece letter and the target square
+	middle := strings.ToLower(san[1 : len(san)-2])
+	if strings.HasSuffix(middle, "x") {
+		move.capture = true
+		middle = strings.TrimSuffix(middle, "x")
+	} else {
+		middle = strings.TrimSuffix(middle, "-")
+	}
+
+	for _, char := range middle {
+		if fileIdx, found := Contains(Files, string(char)); found && move.fromFile == -1 && move.fromRank == -1 {
+			move.fromFile = fileIdx
+			continue
+		}
+
+		if rankIdx, found := Contains(Ranks, int(char-'0')); found && move.fromRank == -1 {
+			move.fromRank = rankIdx
+			continue
+		}
+
+		return move, fmt.Errorf("%w: unexpected %q in %q", ErrInvalidSAN, char, text)
+	}
+
+	return move, nil
+}
+
+// promotionPiece Get the piece type a pawn can promote to from its (English) letter.
+func promotionPiece(letter string) (PieceType, bool) {
+	return English.promotionPiece(letter)
+}
+
+// promotionPiece Get the piece type a pawn can promote to from its letter in the language.
+func (l Language) promotionPiece(letter string) (PieceType, bool) {
+	if pieceType, found := l.pieceType(letter); found && pieceType != King {
+		return pieceType, true
+	}
+	return "", false
+}
+
+// matches Checks if a piece fits the origin square given in the move.
+func (m sanMove) matches(piece Piece) bool {
+	if m.piece != "" && piece.Type() != m.piece {
+		return false
+	}
+
+	if m.fromFile != -1 && piece.Square().file != m.fromFile {
+		return false
+	}
+
+	return m.fromRank == -1 || piece.Square().rank == m.fromRank
+}
+
+// ParseSAN Finds the move on the board written in standard algebraic notation.
+// Long notation ("Ng1-f3", "Ng1f3") is accepted as well.
+func ParseSAN(board *Board, text string) (Move, error) {
+	return ParseLocalSAN(board, text, English)
+}
+
+// ParseLocalSAN Finds the move on the board written in algebraic notation
+// with the piece letters of the language (ex "Sg1-f3" in German).
+func ParseLocalSAN(board *Board, text string, language Language) (Move, error) {
+	san, err := parseLocalSANText(text, board.language(language))
+	if err != nil {
+		return Move{}, err
+	}
+
+	if san.capture && !board.Occupied(san.to) {
+		return Move{}, fmt.Errorf("%w: %q captures on an empty square", ErrIllegalMove, text)
+	}
+
+	var candidates []Piece
+	for _, piece := range board.pieces {
+		if san.matches(piece) && reaches(piece, san.to) {
+			candidates = append(candidates, piece)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return Move{}, fmt.Errorf(
+			"%w: no %s can move to %s",
+			ErrIllegalMove,
+			san.piece,
+			san.to.Notation(),
+		)
+	case 1:
+		return Move{Piece: candidates[0].Type(), From: candidates[0].Square(), To: san.to}, nil
+	default:
+		origins := make([]string, 0, len(candidates))
+		for _, piece := range candidates {
+			origins = append(origins, piece.Square().Notation())
+		}
+
+		return Move{}, fmt.Errorf(
+			"%w: %s from %s can all move to %s",
+			ErrAmbiguousMove,
+			san.piece,
+			strings.Join(origins, ", "),
+			san.to.Notation(),
+		)
+	}
+}
+
+// castlingSAN notation of castling moves by the file the king moves to.
+var castlingSAN = map[int]string{
+	6: "O-O",
+	2: "O-O-O",
+}
+
+// SAN Get the move in standard algebraic notation with check and mate markers
+// (ex "Nbd2", "exd5", "e8=Q+", "O-O"). The move must be legal in the position.
+func (p *Position) SAN(move Move) string {
+	return p.LocalSAN(move, English)
+}
+
+// FigurineSAN Get the move in figurine algebraic notation (ex "♘bd2", "e8=♕+").
+func (p *Position) FigurineSAN(move Move) string {
+	return p.LocalSAN(move, figurines)
+}
+
+// captures Checks if the move takes a piece (pawns capturing en passant included).
+func (p *Position) captures(move Move) bool {
+	_, _, occupied := p.PieceAt(move.To)
+	return occupied || (move.Piece == Pawn && move.From.file != move.To.file)
+}
+
+// LocalSAN Get the move in algebraic notation with the piece letters of the
+// language (ex "Sbd2", "e8=D+" in German).
+func (p *Position) LocalSAN(move Move, language Language) string {
+	var san strings.Builder
+
+	_, _, capture := p.PieceAt(move.To)
+	fileChange := move.From.file != move.To.file
+
+	switch {
+	case move.Piece == King && (move.To.file-move.From.file == 2 || move.From.file-move.To.file == 2):
+		san.WriteString(castlingSAN[move.To.file])
+	case move.Piece == Pawn:
+		if capture || fileChange {
+			san.WriteString(move.From.Notation()[:1] + "x")
+		}
+		san.WriteString(move.To.Notation())
+		if move.Promotion != "" {
+			san.WriteString("=" + language.Letter(move.Promotion))
+		}
+	default:
+		san.WriteString(language.Letter(move.Piece))
+		san.WriteString(p.disambiguation(move))
+		if capture {
+			san.WriteString("x")
+		}
+		san.WriteString(move.To.Notation())
+	}
+
+	if next, err := p.Play(move); err == nil {
+		switch {
+		case next.Checkmate():
+			san.WriteString("#")
+		case next.InCheck():
+			san.WriteString("+")
+		}
+	}
+
+	return san.String()
+}
+
+// disambiguation Get the part of the origin square needed to tell the move apart
+// from legal moves of other pieces of the same type to the same square.
+func (p *Position) disambiguation(move Move) string {
+	sameFile, sameRank, others := false, false, false
+
+	for _, other := range p.LegalMoves() {
+		if other.Piece != move.Piece || other.To.Index() != move.To.Index() || other.From.Index() == move.From.Index() {
+			continue
+		}
+
+		others = true
+		sameFile = sameFile || other.From.file == move.From.file
+		sameRank = sameRank || other.From.rank == move.From.rank
+	}
+
+	notation := move.From.Notation()
+	switch {
+	case !others:
+		return ""
+	case !sameFile:
+		return notation[:1]
+	case !sameRank:
+		return notation[1:]
+	default:
+		return notation
+	}
+}
+
+// ParseSAN Finds the legal move of the side to move written in standard algebraic
+// notation. Castling may be written with letters or zeros ("O-O", "0-0-0") and
+// a missing promotion piece means Queen.
+func (p *Position) ParseSAN(text string) (Move, error) {
+	return p.ParseLocalSAN(text, English)
+}
+
+// ParseLocalSAN Finds the legal move of the side to move written in algebraic
+// notation with the piece letters of the language. Figurines ("♘f3"), ICCF
+// numeric notation ("7163") and spoken ranks ("N f three") are accepted as well.
+func (p *Position) ParseLocalSAN(text string, language Language) (Move, error) {
+	castling := strings.TrimRight(strings.ToUpper(strings.ReplaceAll(text, " ", "")), "+#!?")
+	castling = strings.ReplaceAll(castling, "0", "O")
+	for file, notation := range castlingSAN {
+		if castling != notation {
+			continue
+		}
+
+		for _, move := range p.LegalMoves() {
+			if move.Piece == King && move.From.file == 4 && move.To.file == file {
+				return move, nil
+			}
+		}
+		return Move{}, fmt.Errorf("%w: can't castle %s", ErrIllegalMove, notation)
+	}
+
+	san, err := parseLocalSANText(text, language)
+	if err != nil {
+		return Move{}, err
+	}
+
+	promotion := san.promotion
+	if promotion == "" {
+		promotion = Queen
+	}
+
+	var candidates []Move
+	quiet := false
+	for _, move := range p.LegalMoves() {
+		if (san.piece != "" && move.Piece != san.piece) || move.To.Index() != san.to.Index() {
+			continue
+		}
+		if san.capture && !p.captures(move) {
+			quiet = true
+			continue
+		}
+		if san.fromFile != -1 && move.From.file != san.fromFile {
+			continue
+		}
+		if san.fromRank != -1 && move.From.rank != san.fromRank {
+			continue
+		}
+		if move.Promotion != "" && move.Promotion != promotion {
+			continue
+		}
+		candidates = append(candidates, move)
+	}
+
+	switch {
+	case len(candidates) == 0 && quiet:
+		return Move{}, fmt.Errorf("%w: %q captures on an empty square", ErrIllegalMove, text)
+	case len(candidates) == 0:
+		return Move{}, fmt.Errorf("%w: no %s can move to %s", ErrIllegalMove, san.piece, san.to.Notation())
+	case len(candidates) == 1:
+		return candidates[0], nil
+	default:
+		return Move{}, fmt.Errorf(
+			"%w: more than one %s can move to %s",
+			ErrAmbiguousMove,
+			san.piece,
+			san.to.Notation(),
+		)
+	}
+}
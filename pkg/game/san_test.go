@@ -0,0 +1,348 @@
+package game
+
+import (
+	"errors"
+	"testing"
+)
+
+// newTestBoard Creates a board with pieces on the given squares (ex {"b1": Knight}).
+func newTestBoard(pieces map[string]PieceType) *Board {
+	board := NewBoard()
+
+	for notation, pieceType := range pieces {
+		square, _ := NewSquareFromNotation(notation)
+		board.AddPiece(pieceType, square)
+	}
+
+	return board
+}
+
+func TestMoveSAN(t *testing.T) {
+	board := newTestBoard(map[string]PieceType{
+		"b1": Knight,
+		"f3": Knight,
+		"a1": Rook,
+		"a5": Rook,
+		"c1": Bishop,
+		"h8": Queen,
+		"b8": Queen,
+		"h2": Queen,
+	})
+
+	tests := []struct {
+		from, to string
+		expected string
+	}{
+		{"c1", "e3", "Be3"},
+		{"b1", "d2", "Nbd2"},  // both knights reach d2, files differ
+		{"f3", "d2", "Nfd2"},  // ...
+		{"a1", "a3", "R1a3"},  // same file -> rank
+		{"a5", "a3", "R5a3"},  // ...
+		{"h8", "e5", "Qh8e5"}, // other queens share the file and the rank
+		{"b1", "a3", "Na3"},   // only one knight reaches a3
+	}
+
+	for _, test := range tests {
+		from, _ := NewSquareFromNotation(test.from)
+		to, _ := NewSquareFromNotation(test.to)
+
+		move := Move{Piece: board.PieceAt(from).Type(), From: from, To: to}
+		if san := move.SAN(board); san != test.expected {
+			t.Errorf("expected %s for %s but got %s", test.expected, move, san)
+		}
+	}
+}
+
+func TestMoveSANPawns(t *testing.T) {
+	board := newTestBoard(map[string]PieceType{
+		"d4": Pawn,
+		"c4": Pawn,
+		"b7": Pawn,
+	})
+	e5, _ := NewSquareFromNotation("e5")
+	board.AddColoredPiece(Knight, Black, e5)
+
+	tests := []struct {
+		from, to  string
+		promotion PieceType
+		expected  string
+	}{
+		{"d4", "d5", "", "d5"},
+		{"d4", "e5", "", "dxe5"},
+		{"c4", "d5", "", "cxd5"}, // a pawn changing files captures
+		{"b7", "b8", Queen, "b8=Q"},
+	}
+
+	for _, test := range tests {
+		from, _ := NewSquareFromNotation(test.from)
+		to, _ := NewSquareFromNotation(test.to)
+
+		move := Move{Piece: Pawn, From: from, To: to, Promotion: test.promotion}
+		if san := move.SAN(board); san != test.expected {
+			t.Errorf("expected %s for %s but got %s", test.expected, move, san)
+		}
+	}
+}
+
+func TestParseSAN(t *testing.T) {
+	board := newTestBoard(map[string]PieceType{
+		"b1": Knight,
+		"f3": Knight,
+		"a1": Rook,
+		"e2": Bishop,
+	})
+
+	tests := []struct {
+		text     string
+		from, to string
+	}{
+		{"Nbd2", "b1", "d2"},
+		{"Nf3d2", "f3", "d2"},
+		{"Na3", "b1", "a3"},
+		{"Ra1-a8", "a1", "a8"},
+		{" Bb5+ ", "e2", "b5"},
+		{"Nf3-e5", "f3", "e5"},
+		{"Nf3xe5", "", ""},
+	}
+
+	for _, test := range tests {
+		move, err := ParseSAN(board, test.text)
+
+		if test.from == "" {
+			if err == nil {
+				t.Errorf("expected error for %q", test.text)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.text, err)
+			continue
+		}
+
+		if move.From.Notation() != test.from || move.To.Notation() != test.to {
+			t.Errorf("expected %q to move %s-%s but got %s", test.text, test.from, test.to, move)
+		}
+	}
+}
+
+func TestParseSANErrors(t *testing.T) {
+	board := newTestBoard(map[string]PieceType{
+		"b1": Knight,
+		"f3": Knight,
+		"a1": Rook,
+	})
+
+	tests := []struct {
+		text string
+		err  error
+	}{
+		{"Nd2", ErrAmbiguousMove},
+		{"Nd5", ErrIllegalMove},
+		{"Ng1-h2", ErrIllegalMove}, // f3 knight reaches h2 but there's no knight on g1
+		{"Qd1", ErrIllegalMove},
+		{"Rxa5", ErrIllegalMove},
+		{"Zd2", ErrInvalidSAN},
+		{"Nd9", ErrInvalidSAN},
+		{"Nqd2", ErrInvalidSAN},
+		{"N", ErrInvalidSAN},
+	}
+
+	for _, test := range tests {
+		if _, err := ParseSAN(board, test.text); !errors.Is(err, test.err) {
+			t.Errorf("expected %v for %q but got %v", test.err, test.text, err)
+		}
+	}
+}
+
+func TestPositionSAN(t *testing.T) {
+	position, err := ParseFEN("r3k2r/p1ppqpb1/bn2pnp1/3PN3/1p2P3/2N2Q1p/PPPBBPPP/R3K2R w KQkq - 0 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every legal move survives a round trip through SAN
+	for _, move := range position.LegalMoves() {
+		san := position.SAN(move)
+		parsed, err := position.ParseSAN(san)
+		if err != nil {
+			t.Errorf("can't parse %s: %v", san, err)
+			continue
+		}
+		if parsed.From.Index() != move.From.Index() || parsed.To.Index() != move.To.Index() {
+			t.Errorf("expected %s to be %s but got %s", san, move, parsed)
+		}
+	}
+
+	tests := []struct {
+		fen  string
+		text string
+		san  string
+	}{
+		{StartingFEN, "e4", "e4"},
+		{StartingFEN, "Ng1-f3", "Nf3"},
+		{"r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", "0-0-0", "O-O-O"},
+		{"r3k2r/8/8/8/8/8/8/R3K2R b KQkq - 0 1", "O-O", "O-O"},
+		{"4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", "b8=N", "b8=N"},
+		{"4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", "b8", "b8=Q+"},
+		{"rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3", "exf6", "exf6"},
+		{"r1bqkbnr/pppp1ppp/2n5/4p2Q/2B1P3/8/PPPP1PPP/RNB1K1NR w KQkq - 4 4", "Qxf7", "Qxf7#"},
+		{"4k3/8/8/8/8/8/4K3/R6R w - - 0 1", "Rad1", "Rad1"},
+	}
+
+	for _, test := range tests {
+		position, err := ParseFEN(test.fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		move, err := position.ParseSAN(test.text)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.text, err)
+			continue
+		}
+
+		if san := position.SAN(move); san != test.san {
+			t.Errorf("expected %q to be written %s but got %s", test.text, test.san, san)
+		}
+	}
+
+	// a capture marker needs a piece to capture
+	for _, text := range []string{"e5", "Ke2", "O-O", "Nc3d5", "Nxf3", "Ng1xf3"} {
+		if _, err := NewPosition().ParseSAN(text); !errors.Is(err, ErrIllegalMove) {
+			t.Errorf("expected ErrIllegalMove for %q but got %v", text, err)
+		}
+	}
+}
+
+func TestPositionLocalSAN(t *testing.T) {
+	tests := []struct {
+		fen  string
+		text string
+		san  string
+	}{
+		{StartingFEN, "Sg1-f3", "Sf3"},
+		{StartingFEN, "e4", "e4"},
+		{"r1bqkbnr/pppp1ppp/2n5/4p2Q/2B1P3/8/PPPP1PPP/RNB1K1NR w KQkq - 4 4", "Dxf7", "Dxf7#"},
+		{"4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", "b8=S", "b8=S"},
+		{"4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", "b8=D", "b8=D+"},
+		{"4k3/8/8/8/8/8/4K3/R6R w - - 0 1", "Tad1", "Tad1"},
+	}
+
+	for _, test := range tests {
+		position, err := ParseFEN(test.fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		move, err := position.ParseLocalSAN(test.text, German)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.text, err)
+			continue
+		}
+
+		if san := position.LocalSAN(move, German); san != test.san {
+			t.Errorf("expected %q to be written %s but got %s", test.text, test.san, san)
+		}
+	}
+
+	// English letters are not German ones
+	if _, err := NewPosition().ParseLocalSAN("Nf3", German); !errors.Is(err, ErrInvalidSAN) {
+		t.Errorf("expected ErrInvalidSAN for Nf3 in German but got %v", err)
+	}
+}
+
+func TestParseLocalSAN(t *testing.T) {
+	board := newTestBoard(map[string]PieceType{
+		"b1": Knight,
+		"e2": Bishop,
+	})
+
+	move, err := ParseLocalSAN(board, "Lb5", German)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if move.Piece != Bishop || move.From.Notation() != "e2" {
+		t.Errorf("expected the bishop on e2 to move but got %s", move)
+	}
+
+	if text := move.LocalLongAlgebraic(German); text != "Le2-b5" {
+		t.Errorf("expected Le2-b5 but got %s", text)
+	}
+}
+
+func TestPositionParseSANNotations(t *testing.T) {
+	tests := []struct {
+		fen  string
+		text string
+		san  string
+	}{
+		{StartingFEN, "♘f3", "Nf3"},
+		{StartingFEN, "♞f3", "Nf3"},
+		{StartingFEN, "♙e4", "e4"},
+		{"rnbqkbnr/pppppppp/8/8/4P3/8/PPPP1PPP/RNBQKBNR b KQkq e3 0 1", "♟e5", "e5"},
+		{"rnbqkbnr/ppp1pppp/8/3p4/4P3/8/PPPP1PPP/RNBQKBNR w KQkq d6 0 2", "♙exd5", "exd5"},
+		{StartingFEN, "7163", "Nf3"},
+		{StartingFEN, "5254", "e4"},
+		{StartingFEN, " N f 3 ", "Nf3"},
+		{StartingFEN, "nF3", "Nf3"},
+		{StartingFEN, "E4", "e4"},
+		{StartingFEN, "e four", "e4"},
+		{StartingFEN, "N g1 f three", "Nf3"},
+		{"r3k2r/8/8/8/8/8/8/R3K2R w KQkq - 0 1", "o-o", "O-O"},
+		{"4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", "b8=♘", "b8=N"},
+		{"4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", "27284", "b8=N"},
+		{"4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", "b8q", "b8=Q+"},
+		{"rnbqkbnr/ppp1p1pp/8/3pPp2/8/8/PPPP1PPP/RNBQKBNR w KQkq f6 0 3", "EXF6", "exf6"},
+	}
+
+	for _, test := range tests {
+		position, err := ParseFEN(test.fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		move, err := position.ParseSAN(test.text)
+		if err != nil {
+			t.Errorf("unexpected error for %q: %v", test.text, err)
+			continue
+		}
+
+		if san := position.SAN(move); san != test.san {
+			t.Errorf("expected %q to be %s but got %s", test.text, test.san, san)
+		}
+	}
+}
+
+func TestPositionAlternateNotations(t *testing.T) {
+	tests := []struct {
+		fen      string
+		text     string
+		figurine string
+		iccf     string
+	}{
+		{StartingFEN, "Nf3", "♘f3", "7163"},
+		{StartingFEN, "e4", "e4", "5254"},
+		{"4k3/1P6/8/8/8/8/8/4K3 w - - 0 1", "b8=Q", "b8=♕+", "27281"},
+		{"r1bqkbnr/pppp1ppp/2n5/4p2Q/2B1P3/8/PPPP1PPP/RNB1K1NR w KQkq - 4 4", "Qxf7", "♕xf7#", "8567"},
+	}
+
+	for _, test := range tests {
+		position, err := ParseFEN(test.fen)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		move, err := position.ParseSAN(test.text)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if figurine := position.FigurineSAN(move); figurine != test.figurine {
+			t.Errorf("expected %s in figurines to be %s but got %s", test.text, test.figurine, figurine)
+		}
+		if iccf := move.ICCF(); iccf != test.iccf {
+			t.Errorf("expected %s in ICCF notation to be %s but got %s", test.text, test.iccf, iccf)
+		}
+	}
+}
@@ -33,7 +33,7 @@ type Square struct {
 
 func NewSquare(file, rank int) (*Square, error) {
 	if file < 0 || file >= FileNum || rank < 0 || rank >= RankNum {
-		return nil, fmt.Errorf("Ivalid rank/file: f-%d r-%d", file, rank)
+		return nil, fmt.Errorf("%w: invalid rank/file: f-%d r-%d", ErrInvalidSquare, file, rank)
 	}
 	return &Square{
 		file: file,
@@ -44,7 +44,7 @@ func NewSquare(file, rank int) (*Square, error) {
 // NewSquareFromIndex constructor for creating Square objects from 64-based index.
 func NewSquareFromIndex(index int) (*Square, error) {
 	if index < 0 || index >= (FileNum*RankNum) {
-		return nil, fmt.Errorf("Wrong index: %d. Index should be in range [0, 63]", index)
+		return nil, fmt.Errorf("%w: wrong index %d, index should be in range [0, 63]", ErrInvalidSquare, index)
 	}
 
 	return &Square{
@@ -58,7 +58,8 @@ func NewSquareFromIndex(index int) (*Square, error) {
 func NewSquareFromNotation(notation string) (*Square, error) {
 	if len(notation) != 2 {
 		return nil, fmt.Errorf(
-			"Wrong square notation format %s, expected format \"b7\"",
+			"%w: wrong square notation format %s, expected format \"b7\"",
+			ErrInvalidSquare,
 			notation,
 		)
 	}
@@ -71,7 +72,8 @@ func NewSquareFromNotation(notation string) (*Square, error) {
 	rankInt, err := strconv.Atoi(rankStr)
 	if err != nil {
 		return nil, fmt.Errorf(
-			"Wrong square notation %s, rank is not a number",
+			"%w: wrong square notation %s, rank is not a number",
+			ErrInvalidSquare,
 			notation,
 		)
 	}
@@ -81,7 +83,8 @@ func NewSquareFromNotation(notation string) (*Square, error) {
 
 	if !fileFound || !rankFound {
 		return nil, fmt.Errorf(
-			"Wrong square notation %s, rank/file not found",
+			"%w: wrong square notation %s, rank/file not found",
+			ErrInvalidSquare,
 			notation,
 		)
 	}
@@ -92,6 +95,36 @@ func NewSquareFromNotation(notation string) (*Square, error) {
 	}, nil
 }
 
+// rankWords spoken names of the ranks (ex "four" in "e four").
+var rankWords = []string{"one", "two", "three", "four", "five", "six", "seven", "eight"}
+
+// speakDigits Replaces spoken rank names in the text with their digits ("e four" -> "e 4").
+func speakDigits(text string) string {
+	words := strings.Fields(text)
+	for i, word := range words {
+		if rankIdx, found := Contains(rankWords, strings.ToLower(word)); found {
+			words[i] = fmt.Sprint(Ranks[rankIdx])
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// ParseSquare Get a square from any of its notations: algebraic ("e1"),
+// ICCF numeric ("51") or spoken ("e one"). Whitespace and case don't matter.
+func ParseSquare(text string) (*Square, error) {
+	notation := strings.ToLower(strings.ReplaceAll(speakDigits(text), " ", ""))
+
+	if len(notation) == 2 && notation[0] >= '1' && notation[0] <= '8' {
+		rank, err := strconv.Atoi(notation[1:])
+		if err != nil {
+			return nil, fmt.Errorf("%w: wrong square notation %s", ErrInvalidSquare, text)
+		}
+		return NewSquare(int(notation[0]-'1'), rank-1)
+	}
+
+	return NewSquareFromNotation(notation)
+}
+
 func Contains[T comparable](s []T, e T) (int, bool) {
 	for i, v := range s {
 		if v == e {
@@ -101,6 +134,11 @@ func Contains[T comparable](s []T, e T) (int, bool) {
 	return -1, false
 }
 
+// valid Checks if the square is on the board.
+func (s *Square) valid() bool {
+	return s != nil && s.file >= 0 && s.file < FileNum && s.rank >= 0 && s.rank < RankNum
+}
+
 // Index 64-based index of the square
 func (s *Square) Index() int {
 	return (s.rank * 8) + s.file
@@ -111,6 +149,30 @@ func (s *Square) Notation() string {
 	return fmt.Sprintf("%s%s", Files[s.file], fmt.Sprint(Ranks[s.rank]))
 }
 
+// NumericNotation Get the ICCF numeric notation of the square (ex "51" for e1).
+func (s *Square) NumericNotation() string {
+	return fmt.Sprintf("%d%d", s.file+1, Ranks[s.rank])
+}
+
+// SpokenNotation Get the square as it is said out loud (ex "e four").
+func (s *Square) SpokenNotation() string {
+	return fmt.Sprintf("%s %s", Files[s.file], rankWords[s.rank])
+}
+
+// SpokenCoordinates Get files and ranks (ex the "1" or "b1" telling two rooks
+// apart in "R1e2" or "Rb1d1") the way they are said ("one" or "b one").
+func SpokenCoordinates(text string) string {
+	words := make([]string, 0, len(text))
+	for _, char := range text {
+		if char >= '1' && char <= '8' {
+			words = append(words, rankWords[char-'1'])
+		} else {
+			words = append(words, string(char))
+		}
+	}
+	return strings.Join(words, " ")
+}
+
 // Return the color of the square: Black or White
 func (s *Square) Color() Color {
 	// Convert index to 32-bit representation since the board pattern is the same
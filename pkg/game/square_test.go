@@ -158,3 +158,65 @@ func FuzzSquareColorBlack(f *testing.F) {
 		}
 	})
 }
+
+func FuzzParseSquare(f *testing.F) {
+	f.Add("e1", "e1")
+	f.Add(" D4 ", "d4")
+	f.Add("51", "e1")
+	f.Add("88", "h8")
+	f.Add("e four", "e4")
+	f.Add("G Seven", "g7")
+	f.Add("b 3", "b3")
+
+	f.Fuzz(func(t *testing.T, text, expNotation string) {
+		square, err := ParseSquare(text)
+		if err != nil {
+			t.Fatalf("unexpected error for ParseSquare(%q): %v", text, err)
+		}
+		if notation := square.Notation(); notation != expNotation {
+			t.Errorf("wrong square %s (expected %s) from %q", notation, expNotation, text)
+		}
+	})
+}
+
+func FuzzParseSquareError(f *testing.F) {
+	f.Add("91")
+	f.Add("19")
+	f.Add("e nine")
+	f.Add("")
+
+	f.Fuzz(func(t *testing.T, text string) {
+		if _, err := ParseSquare(text); err == nil {
+			t.Errorf("no error for ParseSquare(%q)", text)
+		}
+	})
+}
+
+func FuzzSquareAlternateNotations(f *testing.F) {
+	f.Add("e1", "51", "e one")
+	f.Add("g3", "73", "g three")
+	f.Add("a8", "18", "a eight")
+
+	f.Fuzz(func(t *testing.T, notation, expNumeric, expSpoken string) {
+		square, _ := NewSquareFromNotation(notation)
+		if numeric := square.NumericNotation(); numeric != expNumeric {
+			t.Errorf("wrong numeric notation %s (expected %s) for %s", numeric, expNumeric, notation)
+		}
+		if spoken := square.SpokenNotation(); spoken != expSpoken {
+			t.Errorf("wrong spoken notation %q (expected %q) for %s", spoken, expSpoken, notation)
+		}
+	})
+}
+
+func FuzzSpokenCoordinates(f *testing.F) {
+	f.Add("1", "one")
+	f.Add("b", "b")
+	f.Add("b1", "b one")
+	f.Add("h8", "h eight")
+
+	f.Fuzz(func(t *testing.T, text, expected string) {
+		if spoken := SpokenCoordinates(text); spoken != expected {
+			t.Errorf("got %q (expected %q) for %q", spoken, expected, text)
+		}
+	})
+}
@@ -1,7 +1,10 @@
 package game
 
 import (
+	"fmt"
 	"math/rand"
+	"strings"
+	"time"
 )
 
 type State int
@@ -20,32 +23,120 @@ var (
 	// Levels for every level add the corresponding piece to the board
 	Levels   = []PieceType{Bishop, Knight, Rook, King, Queen}
 	MaxLevel = len(Levels)
+
+	// EndlessLevels pieces added (in a loop) after the last of the Levels in endless mode
+	EndlessLevels = []PieceType{Knight, Rook, Bishop}
 )
 
-const QuestionsPerLevel = 10
+const (
+	QuestionsPerLevel = 10
+
+	// EndlessMaxPieces once the board holds this many pieces endless mode
+	// stops adding pieces and raises the difficulty instead.
+	EndlessMaxPieces = 10
+	// EndlessTimeLimit time limit used in endless mode if none is configured.
+	EndlessTimeLimit    = 30 * time.Second
+	endlessMinTimeLimit = 5 * time.Second
+	// endlessTimeLimitPercent every difficulty step cuts the time limit to this percentage.
+	endlessTimeLimitPercent = 85
+	// endlessMaxSilentMoves most silent moves that endless difficulty adds up to
+	// (a config with more silent moves keeps them).
+	endlessMaxSilentMoves = 4
+	// endlessLocateDifficulty difficulty from which "Where is the <piece>?" questions are asked.
+	endlessLocateDifficulty = 2
+)
 
 type Game struct {
-	board     *Board
-	currState State
-	level     int
-	Score     int
-
-	questionSquare         *Square
-	pieceForQuestionSquare Piece
+	config     Config
+	seed       int64
+	rng        *rand.Rand
+	board      *Board
+	currState  State
+	level      int
+	difficulty int
+	lives      int
+	Score      int
+	Mistakes   int
+	// Penalty points deducted for hints and peeks
+	Penalty int
+	Peeks   int
+
+	question      Question
+	questionStart time.Time
+	hintsUsed     int
+
+	// training wheels (see Config.TrainingWheels)
+	showBoard             bool
+	wheelsInterval        int
+	questionsWithoutBoard int
+	startTime             time.Time
+	endTime               time.Time
+	transcript            Transcript
+	history               []Move
+	silentSquares         []*Square
+	announced             []Move
+	now                   func() time.Time
 
 	LevelUpPiece Piece
 }
 
+// Outcome Result of answering a question.
+type Outcome struct {
+	Correct  bool
+	TimedOut bool
+	// Piece the piece that could reach the question square (the correct answer)
+	Piece Piece
+	// Label the label of the piece (see Board.Label)
+	Label string
+	// From the square the piece stood on when the question was asked
+	From *Square
+	// Square the question square (where the piece has now moved to unless moves are announced)
+	Square *Square
+	// Explanations why the pieces of the answered type couldn't reach the square
+	Explanations []Explanation
+	LevelUp      bool
+	GameOver     bool
+	Win          bool
+}
+
 func New() *Game {
-	return &Game{
-		board:                  NewBoard(),
-		currState:              PreGame,
-		level:                  0,
-		Score:                  0,
-		questionSquare:         nil,
-		pieceForQuestionSquare: nil,
-		LevelUpPiece:           nil,
+	g, _ := NewWithConfig(DefaultConfig()) // the default config has no pieces to define
+	return g
+}
+
+// NewWithConfig Creates a game with the given settings. Games created with the same
+// non-zero config.Seed get the same starting positions and questions. Fails if
+// one of the config.Pieces can't be defined (see Board.DefinePiece).
+func NewWithConfig(config Config) (*Game, error) {
+	seed := config.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	g := &Game{
+		config:       config,
+		seed:         seed,
+		rng:          rand.New(rand.NewSource(seed)),
+		board:        NewBoard(),
+		currState:    PreGame,
+		level:        0,
+		lives:        config.startingLives(),
+		Score:        0,
+		question:     Question{},
+		now:          time.Now,
+		LevelUpPiece: nil,
+	}
+
+	for _, definition := range config.Pieces {
+		if err := g.board.DefinePiece(definition); err != nil {
+			return nil, err
+		}
 	}
+	return g, nil
+}
+
+func (g *Game) Board() *Board {
+	return g.board
 }
 
 func (g *Game) BoardPieces() []Piece {
@@ -71,99 +162,639 @@ func (g *Game) PieceTypes() []PieceType {
 }
 
 func (g *Game) CheckAnswer(piece PieceType) bool {
-	return piece == g.pieceForQuestionSquare.Type()
+	return g.question.Check(piece)
 }
 
 func (g *Game) Level() int {
 	return g.level + 1 // level is used as index -> return real level number
 }
 
+// Difficulty Get the number of times endless mode made the game harder
+// after the board was full (0 outside of endless mode).
+func (g *Game) Difficulty() int {
+	return g.difficulty
+}
+
+// Language Get the language of the answers with the letters of the game's fairy pieces.
+func (g *Game) Language() Language {
+	return g.board.language(g.config.language())
+}
+
+func (g *Game) Config() Config {
+	return g.config
+}
+
+// Seed Get the seed of the game's random number generator.
+func (g *Game) Seed() int64 {
+	return g.seed
+}
+
+// Duration Get the time spent playing (up to now if the game is not over yet).
+func (g *Game) Duration() time.Duration {
+	if g.startTime.IsZero() {
+		return 0
+	}
+
+	if g.Over() {
+		return g.endTime.Sub(g.startTime)
+	}
+	return g.now().Sub(g.startTime)
+}
+
+// Lives Get the number of remaining lives (always 0 in Practice mode).
+func (g *Game) Lives() int {
+	return g.lives
+}
+
+func (g *Game) State() State {
+	return g.currState
+}
+
+func (g *Game) Over() bool {
+	return g.currState == GameOver || g.currState == Win
+}
+
+// TimeLimit Get the time limit for the current question, 0 means no limit.
+func (g *Game) TimeLimit() time.Duration {
+	if g.config.Mode == Practice {
+		return 0
+	}
+
+	limit := g.config.TimeLimit
+	if !g.config.Endless {
+		return limit
+	}
+
+	if limit == 0 {
+		limit = EndlessTimeLimit
+	}
+
+	for i := 0; i < g.difficulty; i++ {
+		limit = limit * endlessTimeLimitPercent / 100
+	}
+
+	if limit < endlessMinTimeLimit {
+		limit = endlessMinTimeLimit
+	}
+	return limit
+}
+
+// silentMoves Get the number of extra pieces that move between questions.
+func (g *Game) silentMoves() int {
+	limit := endlessMaxSilentMoves
+	if g.config.SilentMoves > limit {
+		limit = g.config.SilentMoves
+	}
+
+	moves := g.config.SilentMoves + g.difficulty
+	if moves > limit {
+		moves = limit
+	}
+	return moves
+}
+
+// SilentSquares Get the squares that pieces silently moved to (apart from the
+// answered question) since the last question. The player has to work out
+// which piece went where.
+func (g *Game) SilentSquares() []*Square {
+	return g.silentSquares
+}
+
 // SetupPreGame Reset board and set 2 initial pieces
-func (g *Game) SetupPreGame() {
+func (g *Game) SetupPreGame() error {
 	g.currState = PreGame
 	g.level = 0
+	g.difficulty = 0
+	g.lives = g.config.startingLives()
 	g.Score = 0
-	g.questionSquare = nil
-	g.pieceForQuestionSquare = nil
+	g.Mistakes = 0
+	g.Penalty = 0
+	g.Peeks = 0
+	g.showBoard = false
+	g.wheelsInterval = 0
+	g.questionsWithoutBoard = 0
+	g.question = Question{}
+	g.silentSquares = nil
+	g.announced = nil
+	g.startTime = time.Time{}
+	g.endTime = time.Time{}
+	g.transcript = nil
+	g.history = nil
 	g.LevelUpPiece = nil
+	g.rng = rand.New(rand.NewSource(g.seed))
+
+	g.record("setup %s seed %d", g.config.Key(), g.seed)
 
 	g.board.Reset()
 
 	// Compute initial piece positions
-	idx1 := generateSquareIndex()
-	idx2 := generateSquareIndex()
+	idx1 := g.generateSquareIndex()
+	idx2 := g.generateSquareIndex()
 
 	for idx1 == idx2 {
-		idx2 = generateSquareIndex()
+		idx2 = g.generateSquareIndex()
 	}
 
 	knightSquare, _ := NewSquareFromIndex(idx1)
-	g.board.AddPiece(Knight, knightSquare)
+	if err := g.addPiece(Knight, knightSquare); err != nil {
+		return err
+	}
 
 	bishopSquare, _ := NewSquareFromIndex(idx2)
-	g.board.AddPiece(Bishop, bishopSquare)
+	if err := g.addPiece(Bishop, bishopSquare); err != nil {
+		return err
+	}
+
+	for _, piece := range g.board.pieces {
+		g.record("piece %s %s", piece.Type(), piece.Square().Notation())
+	}
+	return nil
 }
 
-// chooseSquareAndPiece Chooses a singular square and the piece that can reach it.
-func (g *Game) chooseSquareAndPiece() {
-	squares := g.board.SingularSquares()
+// chooseQuestion Chooses the next question to ask.
+func (g *Game) chooseQuestion() {
+	var ok bool
 
-	g.questionSquare = squares[rand.Intn(len(squares))]
-	g.pieceForQuestionSquare = g.board.PieceThatReachesSquare(g.questionSquare)
+	if g.difficulty >= endlessLocateDifficulty && g.rng.Intn(2) == 0 {
+		g.question, ok = NewLocateQuestion(g.board, g.rng)
+	} else {
+		g.question, ok = NewReachQuestion(g.board, g.rng)
+	}
+
+	if !ok {
+		// crowded boards might not have a square only 1 piece can reach
+		g.question, _ = NewLocateQuestion(g.board, g.rng)
+	}
+
+	g.record("%s", questionLine(g.question))
+	g.updateTrainingWheels()
+	g.questionStart = g.now()
+	g.hintsUsed = 0
 }
 
 func (g *Game) StartGame() {
-	g.chooseSquareAndPiece()
+	g.currState = Play
+	g.startTime = g.now()
+	g.chooseQuestion()
+}
+
+// end Finishes the game in the given state (GameOver or Win).
+func (g *Game) end(state State) {
+	g.currState = state
+	g.endTime = g.now()
+
+	g.record("end score %d points %d level %d", g.Score, g.Points(), g.Level())
+}
+
+func (g *Game) Question() Question {
+	return g.question
 }
 
 func (g *Game) QuestionPieceAndSquare() (Piece, *Square) {
-	return g.pieceForQuestionSquare, g.questionSquare
+	return g.question.Piece, g.question.Square
 }
 
 // SetNextPosition Generates the next position of the board by moving the chosen piece.
 // Return true if level up is hit otherwise, false.
-func (g *Game) SetNextPosition() bool {
-	g.board.MovePiece(g.pieceForQuestionSquare, g.questionSquare)
+func (g *Game) SetNextPosition() (bool, error) {
+	levelUp, _, err := g.setNextPosition()
+	return levelUp, err
+}
 
-	levelUp, _ := g.updateScore()
-	g.chooseSquareAndPiece()
+func (g *Game) setNextPosition() (levelUp, win bool, err error) {
+	if err := g.moveQuestionPiece(); err != nil {
+		return false, false, err
+	}
+
+	levelUp, win, err = g.updateScore()
+	if err != nil || win {
+		if win {
+			g.end(Win)
+		}
+		return levelUp, win, err
+	}
+
+	if err := g.movePieces(); err != nil {
+		return levelUp, win, err
+	}
+	return levelUp, win, nil
+}
+
+// movePieces Plays the announced and silent moves and asks the next question.
+func (g *Game) movePieces() error {
+	if err := g.announceMoves(); err != nil {
+		return err
+	}
+	if err := g.moveSilently(); err != nil {
+		return err
+	}
 
-	return levelUp
+	g.chooseQuestion()
+	return nil
+}
+
+// History Get every change of the board since the start of the game
+// (including the starting pieces).
+func (g *Game) History() []Move {
+	return g.history
+}
+
+// Replay Get a replay of the game history on a board that knows the game's fairy pieces.
+func (g *Game) Replay() *Replay {
+	replay := NewReplay(g.history)
+	for _, definition := range g.board.Definitions() {
+		replay.board.DefinePiece(definition) // already checked by the game board
+	}
+	return replay
+}
+
+// Undo Takes back the last move (or added piece) of the game history and
+// returns it. Only the board and the history are restored, the score stays.
+// While playing the question is asked again about the restored position, so
+// the last piece on the board can't be taken back then.
+func (g *Game) Undo() (Move, error) {
+	if len(g.history) == 0 {
+		return Move{}, ErrNothingToUndo
+	}
+
+	move := g.history[len(g.history)-1]
+	piece := g.board.PieceAt(move.To)
+	if piece == nil {
+		return Move{}, fmt.Errorf("%w: no piece on %s", ErrUnknownPiece, move.To.Notation())
+	}
+
+	if move.From == nil {
+		if g.currState == Play && len(g.board.pieces) == 1 {
+			return Move{}, fmt.Errorf("%w: the last piece stays on the board while playing", ErrNothingToUndo)
+		}
+
+		if err := g.board.removePiece(piece); err != nil {
+			return Move{}, err
+		}
+		if g.LevelUpPiece == piece {
+			g.LevelUpPiece = nil
+		}
+		g.record("undo %s %s", move.Piece, move.To.Notation())
+	} else {
+		piece.SetSquare(move.From)
+		g.record("undo %s", move.LocalLongAlgebraic(g.board.language(English)))
+	}
+
+	g.history = g.history[:len(g.history)-1]
+
+	// the moves since the last question and the question itself belong to the old position
+	g.announced = nil
+	g.silentSquares = nil
+	if g.currState == Play {
+		g.chooseQuestion()
+	} else {
+		g.question = Question{}
+	}
+
+	return move, nil
+}
+
+// addPiece Adds a piece to the board and to the game history.
+func (g *Game) addPiece(pieceType PieceType, square *Square) error {
+	if err := g.board.AddPiece(pieceType, square); err != nil {
+		return err
+	}
+
+	g.history = append(g.history, Move{Piece: pieceType, To: square})
+	return nil
+}
+
+// movePiece Moves a piece on the board and records the move in the game history.
+func (g *Game) movePiece(piece Piece, square *Square) error {
+	from := piece.Square()
+	if err := g.board.MovePiece(piece, square); err != nil {
+		return err
+	}
+
+	g.history = append(g.history, Move{Piece: piece.Type(), From: from, To: square})
+	return nil
+}
+
+// moveQuestionPiece Moves the piece of a ReachQuestion to the question square
+// (unless pieces move only through announced moves).
+func (g *Game) moveQuestionPiece() error {
+	if g.question.Kind == ReachQuestion && g.config.AnnouncedMoves == 0 {
+		return g.movePiece(g.question.Piece, g.question.Square)
+	}
+	return nil
+}
+
+// AnnouncedMoves Get the moves made (and announced to the player) since the last question.
+func (g *Game) AnnouncedMoves() []Move {
+	return g.announced
+}
+
+// announceMoves Plays Config.AnnouncedMoves random moves.
+func (g *Game) announceMoves() error {
+	g.announced = nil
+
+	for i := 0; i < g.config.AnnouncedMoves; i++ {
+		var movable []Piece
+		for _, piece := range g.board.pieces {
+			if len(piece.Moves()) > 0 {
+				movable = append(movable, piece)
+			}
+		}
+
+		if len(movable) == 0 {
+			return nil
+		}
+
+		piece := movable[g.rng.Intn(len(movable))]
+		moves := piece.Moves()
+		move := Move{Piece: piece.Type(), From: piece.Square(), To: moves[g.rng.Intn(len(moves))]}
+
+		if err := g.movePiece(piece, move.To); err != nil {
+			return err
+		}
+		g.announced = append(g.announced, move)
+		g.record("announce %s", move.LocalLongAlgebraic(g.board.language(English)))
+	}
+	return nil
+}
+
+// moveSilently Moves pieces to squares that only they can reach
+// without asking the player about it.
+func (g *Game) moveSilently() error {
+	g.silentSquares = nil
+
+	for i := 0; i < g.silentMoves(); i++ {
+		question, ok := NewReachQuestion(g.board, g.rng)
+		if !ok {
+			return nil
+		}
+
+		if err := g.movePiece(question.Piece, question.Square); err != nil {
+			return err
+		}
+		g.silentSquares = append(g.silentSquares, question.Square)
+		g.record("silent %s", question.Square.Notation())
+	}
+	return nil
+}
+
+// Answer Checks the answer to the current ReachQuestion and moves the game on
+// according to the configured mode. Returns ErrAmbiguousAnswer (without counting
+// the answer) if there is more than one piece of the type, use AnswerPiece then.
+// Like every answer to the wrong kind of question, an answer to a LocateQuestion
+// is not counted and returns ErrInvalidAnswer.
+func (g *Game) Answer(piece PieceType) (Outcome, error) {
+	if g.currState != Play {
+		return Outcome{}, ErrNotPlaying
+	}
+
+	if g.question.Kind != ReachQuestion {
+		return Outcome{}, fmt.Errorf("%w: expected a square", ErrInvalidAnswer)
+	}
+
+	count := 0
+	for _, p := range g.board.pieces {
+		if p.Type() == piece {
+			count++
+		}
+	}
+
+	if count > 1 {
+		return Outcome{}, fmt.Errorf("%w: there are %d pieces of type %s", ErrAmbiguousAnswer, count, piece)
+	}
+
+	g.record("answer %s", piece)
+
+	correct := g.CheckAnswer(piece)
+
+	var explanations []Explanation
+	if !correct {
+		// explain before the pieces move on
+		explanations = g.board.Explain(piece, g.question.Square)
+	}
+
+	outcome, err := g.resolve(correct)
+	outcome.Explanations = explanations
+	return outcome, err
+}
+
+// AnswerPiece Checks the answer (piece ID, see Piece.ID) to the current ReachQuestion
+// and moves the game on according to the configured mode.
+func (g *Game) AnswerPiece(id int) (Outcome, error) {
+	if g.currState != Play {
+		return Outcome{}, ErrNotPlaying
+	}
+
+	if g.question.Kind != ReachQuestion {
+		return Outcome{}, fmt.Errorf("%w: expected a square", ErrInvalidAnswer)
+	}
+
+	piece := g.board.PieceByID(id)
+	if piece == nil {
+		return Outcome{}, fmt.Errorf("%w: no piece with ID %d", ErrInvalidAnswer, id)
+	}
+
+	g.record("answer %s #%d", piece.Type(), id)
+
+	correct := g.question.CheckPiece(piece)
+
+	var explanations []Explanation
+	if !correct {
+		if explanation, ok := g.board.ExplainPiece(piece, g.question.Square); ok {
+			explanations = append(explanations, explanation)
+		}
+	}
+
+	outcome, err := g.resolve(correct)
+	outcome.Explanations = explanations
+	return outcome, err
+}
+
+// AnswerMove Checks a move with its origin square (ex "Ng1-f3" or "Ng1f3")
+// answering the current ReachQuestion, so the player has to know where the
+// piece stands. Returns an ErrInvalidSAN or ErrInvalidAnswer error (without
+// counting the answer) if the text is not a move to the question square or
+// leaves out the origin square.
+func (g *Game) AnswerMove(text string) (Outcome, error) {
+	if g.currState != Play {
+		return Outcome{}, ErrNotPlaying
+	}
+
+	if g.question.Kind != ReachQuestion {
+		return Outcome{}, fmt.Errorf("%w: expected a square", ErrInvalidAnswer)
+	}
+
+	san, err := parseLocalSANText(text, g.Language())
+	if err != nil {
+		return Outcome{}, err
+	}
+
+	if san.to.Index() != g.question.Square.Index() {
+		return Outcome{}, fmt.Errorf(
+			"%w: expected a move to %s",
+			ErrInvalidAnswer,
+			g.question.Square.Notation(),
+		)
+	}
+
+	if san.fromFile < 0 || san.fromRank < 0 {
+		return Outcome{}, fmt.Errorf(
+			"%w: give the square the piece moves from (ex %s)",
+			ErrInvalidAnswer,
+			"Ng1-"+g.question.Square.Notation(),
+		)
+	}
+
+	g.record("answer %s", strings.TrimSpace(text))
+
+	// the square can only be reached by the question piece so a legal
+	// move from the given square has to be made by it
+	_, err = ParseLocalSAN(g.board, text, g.Language())
+	correct := err == nil
+
+	var explanations []Explanation
+	if !correct {
+		pieceType := san.piece
+		if pieceType == "" {
+			// ICCF moves ("7163") don't name the piece, it's the one on the origin square
+			from, _ := NewSquare(san.fromFile, san.fromRank)
+			if occupant := g.board.PieceAt(from); occupant != nil {
+				pieceType = occupant.Type()
+			}
+		}
+		explanations = g.board.Explain(pieceType, g.question.Square)
+	}
+
+	outcome, err := g.resolve(correct)
+	outcome.Explanations = explanations
+	return outcome, err
+}
+
+// AnswerSquare Checks the answer to the current LocateQuestion and moves the
+// game on according to the configured mode.
+func (g *Game) AnswerSquare(square *Square) (Outcome, error) {
+	if g.currState != Play {
+		return Outcome{}, ErrNotPlaying
+	}
+
+	if g.question.Kind != LocateQuestion {
+		return Outcome{}, fmt.Errorf("%w: expected a piece", ErrInvalidAnswer)
+	}
+
+	g.record("answer %s", square.Notation())
+	return g.resolve(g.question.CheckSquare(g.board, square))
+}
+
+// resolve Moves the game on after an answer was checked. Returns an error
+// if the pieces could not be moved on.
+func (g *Game) resolve(correct bool) (Outcome, error) {
+	outcome := Outcome{
+		Correct: correct,
+		Label:   g.board.Label(g.question.Piece),
+		Piece:   g.question.Piece,
+		From:    g.question.Piece.Square(),
+		Square:  g.question.Square,
+	}
+
+	if limit := g.TimeLimit(); limit > 0 && g.now().Sub(g.questionStart) > limit {
+		outcome.Correct = false
+		outcome.TimedOut = true
+	}
+
+	switch {
+	case outcome.TimedOut:
+		g.record("timeout")
+	case outcome.Correct:
+		g.record("correct")
+	default:
+		g.record("wrong")
+	}
+
+	if outcome.Correct {
+		var err error
+		outcome.LevelUp, outcome.Win, err = g.setNextPosition()
+		return outcome, err
+	}
+
+	g.Mistakes++
+
+	if g.config.Mode != Practice {
+		g.lives--
+
+		if g.lives <= 0 {
+			g.end(GameOver)
+			outcome.GameOver = true
+			return outcome, nil
+		}
+	}
+
+	// the piece still moves so the position stays the same as the one
+	// the player was told about, the answer just doesn't score
+	if err := g.moveQuestionPiece(); err != nil {
+		return outcome, err
+	}
+	return outcome, g.movePieces()
 }
 
 // updateScore Updates the score after a correct answer and levels up if necessary.
-func (g *Game) updateScore() (levelUp, win bool) {
+func (g *Game) updateScore() (levelUp, win bool, err error) {
 	g.Score += 1
 
 	// the +1 here is needed because the score starts from 0
-	if g.Score == ((MaxLevel * QuestionsPerLevel) + 1) {
+	if !g.config.Endless && g.Score == ((len(g.config.levels())*QuestionsPerLevel)+1) {
 		// game over - the player won
 		win = true
-		return levelUp, win
+		return levelUp, win, nil
 	}
 
 	if g.Score%QuestionsPerLevel == 0 {
-		var idx int
-		var sq *Square
-
-		for {
-			idx = generateSquareIndex()
-			sq, _ = NewSquareFromIndex(idx)
-			if !g.board.Occupied(sq) {
-				break
+		g.LevelUpPiece = nil
+
+		newPiece, ok := g.nextLevelPiece()
+		if ok {
+			var idx int
+			var sq *Square
+
+			for {
+				idx = g.generateSquareIndex()
+				sq, _ = NewSquareFromIndex(idx)
+				if !g.board.Occupied(sq) {
+					break
+				}
 			}
-		}
 
-		newPiece := Levels[g.level]
-		g.board.AddPiece(newPiece, sq)
-		g.LevelUpPiece = g.board.pieces[len(g.board.pieces)-1]
+			if err := g.addPiece(newPiece, sq); err != nil {
+				return levelUp, win, err
+			}
+			g.LevelUpPiece = g.board.pieces[len(g.board.pieces)-1]
+			g.record("levelup %s %s", newPiece, sq.Notation())
+		} else if g.config.Endless {
+			// the board is full -> make the game harder instead
+			g.difficulty++
+			g.record("difficulty %d", g.difficulty)
+		}
 
 		g.level++
 		levelUp = true
 	}
-	return levelUp, win
+	return levelUp, win, nil
+}
+
+// nextLevelPiece Get the piece to add on level up. After the last of the levels
+// endless mode keeps adding EndlessLevels until the board holds EndlessMaxPieces.
+func (g *Game) nextLevelPiece() (PieceType, bool) {
+	levels := g.config.levels()
+	if g.level < len(levels) {
+		return levels[g.level], true
+	}
+
+	if !g.config.Endless || len(g.board.pieces) >= EndlessMaxPieces {
+		return "", false
+	}
+
+	return EndlessLevels[(g.level-len(levels))%len(EndlessLevels)], true
 }
 
-func generateSquareIndex() int {
-	return rand.Intn(FileNum * RankNum)
+func (g *Game) generateSquareIndex() int {
+	return g.rng.Intn(FileNum * RankNum)
 }
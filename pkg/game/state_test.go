@@ -0,0 +1,550 @@
+package game
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// wrongAnswer Get a piece type that is on the board but isn't the answer to the current question.
+func wrongAnswer(g *Game) PieceType {
+	questionPiece, _ := g.QuestionPieceAndSquare()
+
+	for _, pieceType := range g.PieceTypes() {
+		if pieceType != questionPiece.Type() {
+			return pieceType
+		}
+	}
+	return ""
+}
+
+func TestGameSuddenDeath(t *testing.T) {
+	g := New()
+	g.SetupPreGame()
+	g.StartGame()
+
+	outcome, err := g.Answer(wrongAnswer(g))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if outcome.Correct || !outcome.GameOver || !g.Over() {
+		t.Errorf("expected game over after first wrong answer, got %+v", outcome)
+	}
+
+	if _, err := g.Answer(Knight); err != ErrNotPlaying {
+		t.Errorf("expected ErrNotPlaying after game over but got %v", err)
+	}
+
+	transcript := g.Transcript()
+	if !strings.HasPrefix(transcript[0], "setup sudden") || transcript[len(transcript)-1] != "end score 0 points 0 level 1" {
+		t.Errorf("unexpected transcript:\n%s", transcript)
+	}
+}
+
+func TestGameMultipleLives(t *testing.T) {
+	g := newTestGame(t, Config{Mode: MultipleLives, Lives: 3})
+	g.SetupPreGame()
+	g.StartGame()
+
+	for i := 0; i < 2; i++ {
+		outcome, _ := g.Answer(wrongAnswer(g))
+		if outcome.GameOver {
+			t.Fatalf("game over after %d wrong answers with 3 lives", i+1)
+		}
+	}
+
+	if g.Lives() != 1 {
+		t.Errorf("expected 1 life left but got %d", g.Lives())
+	}
+
+	questionPiece, _ := g.QuestionPieceAndSquare()
+	outcome, _ := g.Answer(questionPiece.Type())
+	if !outcome.Correct || g.Score != 1 {
+		t.Errorf("expected correct answer to score, got %+v (score %d)", outcome, g.Score)
+	}
+
+	outcome, _ = g.Answer(wrongAnswer(g))
+	if !outcome.GameOver {
+		t.Errorf("expected game over after last life was lost")
+	}
+}
+
+func TestGamePractice(t *testing.T) {
+	g := newTestGame(t, Config{Mode: Practice})
+	g.SetupPreGame()
+	g.StartGame()
+
+	for i := 0; i < 20; i++ {
+		questionPiece, questionSquare := g.QuestionPieceAndSquare()
+
+		outcome, err := g.Answer(wrongAnswer(g))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if outcome.GameOver {
+			t.Fatalf("practice game ended after %d wrong answers", i+1)
+		}
+
+		if outcome.Piece != questionPiece || outcome.Square != questionSquare {
+			t.Errorf("outcome doesn't explain the question that was asked")
+		}
+
+		if questionPiece.Square().Index() != questionSquare.Index() {
+			t.Errorf("expected the question piece to move to the question square")
+		}
+	}
+
+	if g.Score != 0 || g.Mistakes != 20 {
+		t.Errorf("expected score 0 with 20 mistakes but got %d/%d", g.Score, g.Mistakes)
+	}
+}
+
+// newTestGame Creates a game with the config, the config must be valid.
+func newTestGame(t *testing.T, config Config) *Game {
+	t.Helper()
+
+	g, err := NewWithConfig(config)
+	if err != nil {
+		t.Fatalf("can't create a game: %v", err)
+	}
+	return g
+}
+
+// answerCorrectly Answers the current question of the game correctly.
+func answerCorrectly(t *testing.T, g *Game) Outcome {
+	t.Helper()
+
+	question := g.Question()
+
+	var outcome Outcome
+	var err error
+	if question.Kind == LocateQuestion {
+		outcome, err = g.AnswerSquare(question.Square)
+	} else {
+		outcome, err = g.AnswerPiece(question.Piece.ID())
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !outcome.Correct {
+		t.Fatalf("expected correct answer for %+v", question)
+	}
+	return outcome
+}
+
+func TestGameWin(t *testing.T) {
+	g := New()
+	g.SetupPreGame()
+	g.StartGame()
+
+	var outcome Outcome
+	for !outcome.Win {
+		outcome = answerCorrectly(t, g)
+	}
+
+	if g.State() != Win || g.Score != MaxLevel*QuestionsPerLevel+1 {
+		t.Errorf("expected win with score %d but got state %d score %d", MaxLevel*QuestionsPerLevel+1, g.State(), g.Score)
+	}
+}
+
+func TestGameLevelUpError(t *testing.T) {
+	g := newTestGame(t, Config{Mode: Practice, Levels: []PieceType{"Dragon"}})
+	if err := g.SetupPreGame(); err != nil {
+		t.Fatal(err)
+	}
+	g.StartGame()
+
+	for i := 1; i < QuestionsPerLevel; i++ {
+		answerCorrectly(t, g)
+	}
+
+	// the level up can't add a piece of an unknown type
+	question := g.Question()
+	var err error
+	if question.Kind == LocateQuestion {
+		_, err = g.AnswerSquare(question.Square)
+	} else {
+		_, err = g.AnswerPiece(question.Piece.ID())
+	}
+
+	if !errors.Is(err, ErrUnknownPiece) {
+		t.Errorf("expected ErrUnknownPiece on level up but got %v", err)
+	}
+}
+
+func TestGameEndless(t *testing.T) {
+	g := newTestGame(t, Config{Mode: SuddenDeath, Endless: true})
+	g.SetupPreGame()
+	g.StartGame()
+
+	startLimit := g.TimeLimit()
+	if startLimit != EndlessTimeLimit {
+		t.Errorf("expected default endless time limit %s but got %s", EndlessTimeLimit, startLimit)
+	}
+
+	for g.Difficulty() < endlessLocateDifficulty+1 {
+		outcome := answerCorrectly(t, g)
+
+		if outcome.Win {
+			t.Fatalf("endless game shouldn't be won (score %d)", g.Score)
+		}
+
+		if len(g.BoardPieces()) > EndlessMaxPieces {
+			t.Fatalf("expected at most %d pieces but got %d", EndlessMaxPieces, len(g.BoardPieces()))
+		}
+	}
+
+	if len(g.BoardPieces()) != EndlessMaxPieces {
+		t.Errorf("expected a full board with %d pieces but got %d", EndlessMaxPieces, len(g.BoardPieces()))
+	}
+
+	if g.TimeLimit() >= startLimit {
+		t.Errorf("expected time limit to shrink from %s but got %s", startLimit, g.TimeLimit())
+	}
+
+	answerCorrectly(t, g)
+	if len(g.SilentSquares()) == 0 {
+		t.Errorf("expected silent moves at difficulty %d", g.Difficulty())
+	}
+}
+
+func TestGameSilentMovesDifficulty(t *testing.T) {
+	tests := []struct {
+		silentMoves int
+		difficulty  int
+		expected    int
+	}{
+		{0, 0, 0},
+		{1, 2, 3},
+		{1, 10, endlessMaxSilentMoves},
+		// difficulty never takes configured silent moves away
+		{6, 0, 6},
+		{6, 3, 6},
+	}
+
+	for _, test := range tests {
+		g := newTestGame(t, Config{Mode: SuddenDeath, Endless: true, SilentMoves: test.silentMoves})
+		g.difficulty = test.difficulty
+
+		if moves := g.silentMoves(); moves != test.expected {
+			t.Errorf(
+				"expected %d silent moves for %d configured at difficulty %d but got %d",
+				test.expected, test.silentMoves, test.difficulty, moves,
+			)
+		}
+	}
+}
+
+func TestGameTimeLimit(t *testing.T) {
+	g := newTestGame(t, Config{Mode: SuddenDeath, TimeLimit: 10 * time.Second})
+
+	now := time.Now()
+	g.now = func() time.Time { return now }
+
+	g.SetupPreGame()
+	g.StartGame()
+
+	now = now.Add(11 * time.Second)
+
+	questionPiece, _ := g.QuestionPieceAndSquare()
+	outcome, _ := g.Answer(questionPiece.Type())
+	if outcome.Correct || !outcome.TimedOut || !outcome.GameOver {
+		t.Errorf("expected late answer to time out, got %+v", outcome)
+	}
+}
+
+func TestConfigKey(t *testing.T) {
+	configs := []Config{
+		{Mode: SuddenDeath},
+		{Mode: SuddenDeath, Endless: true},
+		{Mode: MultipleLives, Lives: 3},
+		{Mode: MultipleLives, Lives: 5},
+		{Mode: Practice},
+		{Mode: Practice, Pieces: FairyPieces[:1]},
+		{Mode: Practice, Pieces: []PieceDefinition{{Type: "Zebra", Letter: "Z", Betza: "Z"}}},
+		{Mode: Practice, Pieces: []PieceDefinition{{Type: "Zebra", Letter: "Z", Betza: "NN"}}},
+	}
+
+	seen := map[string]struct{}{}
+	for _, config := range configs {
+		key := config.Key()
+		if _, ok := seen[key]; ok {
+			t.Errorf("duplicate key %s for %+v", key, config)
+		}
+		seen[key] = struct{}{}
+	}
+}
+
+func TestGameAnnouncedMoves(t *testing.T) {
+	g := newTestGame(t, Config{Mode: Practice, AnnouncedMoves: 2})
+	g.SetupPreGame()
+	g.StartGame()
+
+	for i := 0; i < 15; i++ {
+		question := g.Question()
+		from := question.Piece.Square()
+
+		answerCorrectly(t, g)
+
+		announced := g.AnnouncedMoves()
+		if len(announced) != 2 {
+			t.Fatalf("expected 2 announced moves but got %d", len(announced))
+		}
+
+		// only announced moves change the board
+		moved := false
+		for _, move := range announced {
+			if move.From.Index() == from.Index() {
+				moved = true
+			}
+		}
+		if !moved && question.Piece.Square().Index() != from.Index() {
+			t.Errorf("question piece moved without an announcement")
+		}
+	}
+
+	replay := g.Replay()
+	for {
+		if _, ok := replay.Next(); !ok {
+			break
+		}
+	}
+
+	if replay.Board().Render(DefaultRenderOptions()) != g.Board().Render(DefaultRenderOptions()) {
+		t.Errorf("announced moves are missing from the history")
+	}
+}
+
+func TestGameAnswerMove(t *testing.T) {
+	g := newTestGame(t, Config{Mode: Practice, Answers: MoveAnswers})
+	g.SetupPreGame()
+	g.StartGame()
+
+	questionPiece, questionSquare := g.QuestionPieceAndSquare()
+	letter := questionPiece.Type().Letter()
+
+	if _, err := g.AnswerMove("Zz9"); !errors.Is(err, ErrInvalidSAN) {
+		t.Errorf("expected ErrInvalidSAN for garbage but got %v", err)
+	}
+
+	otherSquare, _ := NewSquare((questionSquare.file+1)%FileNum, questionSquare.rank)
+	if _, err := g.AnswerMove(letter + otherSquare.Notation()); !errors.Is(err, ErrInvalidAnswer) {
+		t.Errorf("expected ErrInvalidAnswer for a move to another square but got %v", err)
+	}
+
+	if g.Mistakes != 0 {
+		t.Fatalf("invalid input shouldn't count as a mistake")
+	}
+
+	// right piece, wrong origin
+	wrongOrigin, _ := NewSquare((questionPiece.Square().file+1)%FileNum, questionPiece.Square().rank)
+	outcome, err := g.AnswerMove(letter + wrongOrigin.Notation() + "-" + questionSquare.Notation())
+	if err != nil || outcome.Correct {
+		t.Errorf("expected wrong origin to be a wrong answer, got %+v %v", outcome, err)
+	}
+
+	questionPiece, questionSquare = g.QuestionPieceAndSquare()
+	long := questionPiece.Type().Letter() + questionPiece.Square().Notation() + "-" + questionSquare.Notation()
+	if outcome, err := g.AnswerMove(long); err != nil || !outcome.Correct {
+		t.Errorf("expected %s to be correct, got %+v %v", long, outcome, err)
+	}
+
+	questionPiece, questionSquare = g.QuestionPieceAndSquare()
+	short := questionPiece.Type().Letter() + questionSquare.Notation()
+	if _, err := g.AnswerMove(short); !errors.Is(err, ErrInvalidAnswer) {
+		t.Errorf("expected ErrInvalidAnswer for %s without the origin but got %v", short, err)
+	}
+
+	// only the file of the origin doesn't prove the player knows the square either
+	fileOnly := questionPiece.Type().Letter() + questionPiece.Square().Notation()[:1] + questionSquare.Notation()
+	if _, err := g.AnswerMove(fileOnly); !errors.Is(err, ErrInvalidAnswer) {
+		t.Errorf("expected ErrInvalidAnswer for %s but got %v", fileOnly, err)
+	}
+
+	if g.Mistakes != 1 {
+		t.Errorf("moves without an origin shouldn't count as mistakes, got %d", g.Mistakes)
+	}
+
+	joined := questionPiece.Type().Letter() + questionPiece.Square().Notation() + questionSquare.Notation()
+	if outcome, err := g.AnswerMove(joined); err != nil || !outcome.Correct {
+		t.Errorf("expected %s to be correct, got %+v %v", joined, outcome, err)
+	}
+
+	// a wrong ICCF move is explained with the piece on its origin square
+	questionPiece, questionSquare = g.QuestionPieceAndSquare()
+	for _, other := range g.BoardPieces() {
+		if other.ID() == questionPiece.ID() {
+			continue
+		}
+
+		iccf := other.Square().NumericNotation() + questionSquare.NumericNotation()
+		outcome, err := g.AnswerMove(iccf)
+		if err != nil || outcome.Correct || len(outcome.Explanations) == 0 {
+			t.Errorf("expected %s to be wrong with an explanation, got %+v %v", iccf, outcome, err)
+		}
+		break
+	}
+}
+
+func TestGameAnswerWrongKind(t *testing.T) {
+	g := newTestGame(t, Config{Mode: MultipleLives, Lives: 3, Answers: MoveAnswers})
+	g.SetupPreGame()
+	g.StartGame()
+
+	question := g.Question()
+	lives, score := g.Lives(), g.Score
+
+	g.question.Kind = LocateQuestion
+	if _, err := g.Answer(question.Piece.Type()); !errors.Is(err, ErrInvalidAnswer) {
+		t.Errorf("expected ErrInvalidAnswer for a piece type but got %v", err)
+	}
+	if _, err := g.AnswerPiece(question.Piece.ID()); !errors.Is(err, ErrInvalidAnswer) {
+		t.Errorf("expected ErrInvalidAnswer for a piece but got %v", err)
+	}
+	long := question.Piece.Type().Letter() + question.Piece.Square().Notation() + "-" + question.Square.Notation()
+	if _, err := g.AnswerMove(long); !errors.Is(err, ErrInvalidAnswer) {
+		t.Errorf("expected ErrInvalidAnswer for a move but got %v", err)
+	}
+
+	g.question.Kind = ReachQuestion
+	if _, err := g.AnswerSquare(question.Square); !errors.Is(err, ErrInvalidAnswer) {
+		t.Errorf("expected ErrInvalidAnswer for a square but got %v", err)
+	}
+
+	if g.Lives() != lives || g.Score != score || g.Mistakes != 0 {
+		t.Errorf("answers to the wrong kind of question shouldn't count, got lives %d score %d mistakes %d", g.Lives(), g.Score, g.Mistakes)
+	}
+}
+
+func TestGameAmbiguousAnswer(t *testing.T) {
+	g := newTestGame(t, Config{Mode: SuddenDeath})
+	g.SetupPreGame()
+
+	// add a second knight so that "Knight" no longer names a single piece
+	for idx := 0; idx < FileNum*RankNum; idx++ {
+		square, _ := NewSquareFromIndex(idx)
+		if !g.board.Occupied(square) {
+			g.addPiece(Knight, square)
+			break
+		}
+	}
+	g.StartGame()
+
+	if _, err := g.Answer(Knight); !errors.Is(err, ErrAmbiguousAnswer) {
+		t.Errorf("expected ErrAmbiguousAnswer but got %v", err)
+	}
+
+	if g.Over() || g.Mistakes != 0 {
+		t.Errorf("ambiguous answer shouldn't be counted")
+	}
+
+	question := g.Question()
+	for _, piece := range g.BoardPieces() {
+		if piece.Type() == question.Piece.Type() && piece.ID() != question.Piece.ID() {
+			outcome, _ := g.AnswerPiece(piece.ID())
+			if outcome.Correct {
+				t.Errorf("expected another piece of the same type to be a wrong answer")
+			}
+			return
+		}
+	}
+
+	if outcome, _ := g.AnswerPiece(question.Piece.ID()); !outcome.Correct {
+		t.Errorf("expected the question piece to be the right answer")
+	}
+}
+
+func TestGameUndo(t *testing.T) {
+	g := newTestGame(t, Config{Mode: Practice, Seed: 7})
+	g.SetupPreGame()
+	g.StartGame()
+
+	start := g.Board().Render(DefaultRenderOptions())
+	for i := 0; i < 5; i++ {
+		answerCorrectly(t, g)
+	}
+
+	history := len(g.History())
+	if history <= 2 {
+		t.Fatalf("expected moves in the history but got %d entries", history)
+	}
+
+	for len(g.History()) > 2 {
+		if _, err := g.Undo(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if g.Board().Render(DefaultRenderOptions()) != start {
+		t.Errorf("expected undo to restore the starting position")
+	}
+
+	move, err := g.Undo()
+	if err != nil || move.From != nil {
+		t.Fatalf("expected to take back an added piece but got %v (%v)", move, err)
+	}
+
+	// a question has to be asked about the last piece
+	if _, err := g.Undo(); !errors.Is(err, ErrNothingToUndo) {
+		t.Errorf("expected ErrNothingToUndo for the last piece while playing but got %v", err)
+	}
+
+	g.end(GameOver)
+	if _, err := g.Undo(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(g.BoardPieces()) != 0 {
+		t.Errorf("expected an empty board after undoing everything")
+	}
+
+	if _, err := g.Undo(); !errors.Is(err, ErrNothingToUndo) {
+		t.Errorf("expected ErrNothingToUndo but got %v", err)
+	}
+}
+
+func TestGameUndoOpenQuestion(t *testing.T) {
+	g := newTestGame(t, Config{Mode: Practice, Seed: 3})
+	if err := g.SetupPreGame(); err != nil {
+		t.Fatal(err)
+	}
+	g.StartGame()
+
+	// play until a level up added a piece, then take it back with its question open
+	for len(g.BoardPieces()) < 3 {
+		answerCorrectly(t, g)
+	}
+	for g.History()[len(g.History())-1].From != nil {
+		if _, err := g.Undo(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	added := g.LevelUpPiece
+	move, err := g.Undo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Board().PieceAt(move.To) != nil || g.LevelUpPiece != nil {
+		t.Fatalf("expected the %s on %s (%v) to be taken back", move.Piece, move.To.Notation(), added)
+	}
+
+	question := g.Question()
+	if g.Board().PieceByID(question.Piece.ID()) == nil {
+		t.Errorf("question about the %s that was taken back", question.Piece.Type())
+	}
+	if question.Kind == ReachQuestion && !question.CheckPiece(question.Piece) {
+		t.Errorf("the %s can't reach %s after the undo", question.Piece.Type(), question.Square.Notation())
+	}
+
+	if transcript := g.Transcript().String(); !strings.Contains(transcript, "undo "+string(move.Piece)+" "+move.To.Notation()) {
+		t.Errorf("expected the undo in the transcript:\n%s", transcript)
+	}
+
+	// the new question can be answered as usual
+	answerCorrectly(t, g)
+}
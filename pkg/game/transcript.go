@@ -0,0 +1,31 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Transcript Lines describing everything that happened during a game
+// (the position, questions, answers and their results) in order.
+type Transcript []string
+
+func (t Transcript) String() string {
+	return strings.Join(t, "\n")
+}
+
+// record Adds a line to the game's transcript.
+func (g *Game) record(format string, args ...any) {
+	g.transcript = append(g.transcript, fmt.Sprintf(format, args...))
+}
+
+func (g *Game) Transcript() Transcript {
+	return g.transcript
+}
+
+// questionLine Get the transcript line of a question.
+func questionLine(q Question) string {
+	if q.Kind == LocateQuestion {
+		return fmt.Sprintf("question locate %s #%d", q.Piece.Type(), q.Piece.ID())
+	}
+	return fmt.Sprintf("question reach %s", q.Square.Notation())
+}
@@ -0,0 +1,50 @@
+package game
+
+import (
+	"time"
+)
+
+// Peek Allows a look at the board during the game in exchange for
+// Config.PeekCost points. Returns how long the board should be shown.
+func (g *Game) Peek() (time.Duration, error) {
+	if g.currState != Play {
+		return 0, ErrNotPlaying
+	}
+
+	g.Penalty += g.config.PeekCost
+	g.Peeks++
+	g.record("peek cost %d", g.config.PeekCost)
+
+	if g.config.PeekDuration == 0 {
+		return DefaultPeekDuration, nil
+	}
+	return g.config.PeekDuration, nil
+}
+
+// ShowBoard Checks if the board should be shown before the current question
+// (see Config.TrainingWheels).
+func (g *Game) ShowBoard() bool {
+	return g.showBoard
+}
+
+// updateTrainingWheels Decides if the board is shown before the next question.
+func (g *Game) updateTrainingWheels() {
+	g.showBoard = false
+	if g.config.TrainingWheels <= 0 {
+		return
+	}
+
+	if g.wheelsInterval == 0 {
+		g.wheelsInterval = g.config.TrainingWheels
+	}
+
+	g.questionsWithoutBoard++
+	if g.questionsWithoutBoard <= g.wheelsInterval {
+		return
+	}
+
+	g.showBoard = true
+	g.questionsWithoutBoard = 0
+	g.wheelsInterval++
+	g.record("show board")
+}
@@ -0,0 +1,47 @@
+package game
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGamePeek(t *testing.T) {
+	g := newTestGame(t, Config{Mode: Practice, PeekCost: 4, PeekDuration: time.Second})
+	g.SetupPreGame()
+	g.StartGame()
+
+	duration, err := g.Peek()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if duration != time.Second || g.Points() != -4 || g.Peeks != 1 {
+		t.Errorf("expected 1s peek costing 4 points, got %s and %d points", duration, g.Points())
+	}
+}
+
+func TestGameTrainingWheels(t *testing.T) {
+	g := newTestGame(t, Config{Mode: Practice, TrainingWheels: 2})
+	g.SetupPreGame()
+	g.StartGame()
+
+	var shownAt []int
+	for question := 1; question <= 12; question++ {
+		if g.ShowBoard() {
+			shownAt = append(shownAt, question)
+		}
+		answerCorrectly(t, g)
+	}
+
+	// shown after 2, then 3, then 4 questions without the board
+	expected := []int{3, 7, 12}
+	if len(shownAt) != len(expected) {
+		t.Fatalf("expected board to be shown before questions %v but got %v", expected, shownAt)
+	}
+
+	for idx := range expected {
+		if shownAt[idx] != expected[idx] {
+			t.Errorf("expected board to be shown before questions %v but got %v", expected, shownAt)
+		}
+	}
+}
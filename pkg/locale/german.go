@@ -0,0 +1,144 @@
+package locale
+
+// german German texts by their English original.
+var german = map[string]string{
+	// classic game
+	"Level %d Score %d/%d Total %d Points %d": "Stufe %d Punktestand %d/%d Gesamt %d Punkte %d",
+	" Lives %d":                     " Leben %d",
+	"Unknown game mode %s\n":        "Unbekannter Spielmodus %s\n",
+	"Unknown answer type %s\n":      "Unbekannte Antwortart %s\n",
+	"Too slow! ":                    "Zu langsam! ",
+	"Wrong! ":                       "Falsch! ",
+	"The %s is on %s\n":             "%s steht auf %s\n",
+	"Moves":                         "Züge",
+	"Hint (-%d points): ":           "Tipp (-%d Punkte): ",
+	"Peek (-%d points)\n":           "Blick aufs Brett (-%d Punkte)\n",
+	"Starting position":             "Ausgangsstellung",
+	"Game starts in %s\n":           "Das Spiel beginnt in %s\n",
+	"Press Enter when ready":        "Drücke Enter, wenn du bereit bist",
+	"(%s to answer) ":               "(%s zum Antworten) ",
+	"Which piece can go to %s":      "Welche Figur kann nach %s ziehen",
+	"Game over! %s":                 "Spiel vorbei! %s",
+	"You win! %s":                   "Gewonnen! %s",
+	"Success! %s":                   "Richtig! %s",
+	"the piece stands on %s\n":      "die Figur steht auf %s\n",
+	"Pieces also moved silently to": "Außerdem zogen Figuren unangekündigt nach",
+	"The %s on %s was the only piece that could go to %s\n":                                               "%s auf %s war die einzige Figur, die nach %s ziehen konnte\n",
+	"Answer should be an number corresponding to the piece from available options":                        "Die Antwort muss die Nummer einer der angebotenen Figuren sein",
+	"Answer should be a number between 0 and %d (inclusive)":                                              "Die Antwort muss eine Zahl von 0 bis %d sein",
+	"the piece stands on a %s square\n":                                                                   "die Figur steht auf einem %sen Feld\n",
+	"the piece stands on the %s-file\n":                                                                   "die Figur steht auf der %s-Linie\n",
+	"Where is the %s (ex. e4, %s for a hint, %s to peek):\n":                                              "Wo steht %s (z.B. e4, %s für einen Tipp, %s für einen Blick aufs Brett):\n",
+	"Which piece can go to %s? Enter the move with its origin (ex. Ng1-f3, %s for a hint, %s to peek):\n": "Welche Figur kann nach %s ziehen? Gib den Zug mit dem Ausgangsfeld ein (z.B. Sg1-f3, %s für einen Tipp, %s für einen Blick aufs Brett):\n",
+	"%s (%s, %s for a hint, %s to peek):":                                                                 "%s (%s, %s für einen Tipp, %s für einen Blick aufs Brett):",
+	"Level up! A new %s was added to %s\n":                                                                "Nächste Stufe! Neue Figur %s auf %s\n",
+	"Level up! The board is full, difficulty raised to %d\n":                                              "Nächste Stufe! Das Brett ist voll, Schwierigkeit auf %d erhöht\n",
+
+	// review
+	"your %s on %s cannot reach %s, it doesn't move that way":      "deine Figur %s auf %s kann %s nicht erreichen, so zieht sie nicht",
+	"your %s on %s cannot reach %s because the %s on %s blocks it": "deine Figur %s auf %s kann %s nicht erreichen, weil %s auf %s im Weg steht",
+	"Final position": "Endstellung",
+	"Press Enter to replay the game move by move (q to quit): ": "Drücke Enter, um die Partie Zug für Zug nachzuspielen (q zum Beenden): ",
+	"Step %d/%d: %s\n":                      "Schritt %d/%d: %s\n",
+	"Enter for the next move (q to quit): ": "Enter für den nächsten Zug (q zum Beenden): ",
+	"%s added on %s":                        "%s auf %s hinzugefügt",
+	"%s %s to %s":                           "%s von %s nach %s",
+
+	// accessible mode
+	"Which piece can go to %s?\n": "Welche Figur kann nach %s ziehen?\n",
+	"%s for a hint, %s to peek\n": "%s für einen Tipp, %s für einen Blick aufs Brett\n",
+	"castles kingside":            "kurze Rochade",
+	"castles queenside":           "lange Rochade",
+	"takes":                       "schlägt",
+	"promotes to %s":              "Umwandlung in %s",
+	"check":                       "Schach",
+	"checkmate":                   "Schachmatt",
+
+	// replay, opening drill and puzzles
+	"Which piece can go to %s? (answer with its square) ": "Welche Figur kann nach %s ziehen? (antworte mit ihrem Feld) ",
+	"Where is the %s? ":            "Wo steht %s? ",
+	"black %s":                     "%s (schwarz)",
+	"white %s":                     "%s (weiß)",
+	"%q is not a square\n":         "%q ist kein Feld\n",
+	"Correct":                      "Richtig",
+	"Correct!":                     "Richtig!",
+	"Wrong! The %s on %s\n":        "Falsch! %s auf %s\n",
+	"Failed to load %s: %v\n":      "%s konnte nicht geladen werden: %v\n",
+	"%s has %d games:\n":           "%s enthält %d Partien:\n",
+	"Choose a PGN file with -file": "Wähle mit -file eine PGN-Datei",
+	"Failed to replay %s: %v\n":    "%s konnte nicht nachgespielt werden: %v\n",
+	"Answer with squares, type %s to see the board or %s to hear the position\n":      "Antworte mit Feldern, %s zeigt das Brett, %s beschreibt die Stellung\n",
+	"Result %s. You answered %d of %d questions correctly\n":                          "Ergebnis %s. Du hast %d von %d Fragen richtig beantwortet\n",
+	"Choose a file of opening lines with -file":                                       "Wähle mit -file eine Datei mit Eröffnungsvarianten",
+	"Wrong, the line continues %s\n":                                                  "Falsch, die Variante geht mit %s weiter\n",
+	"\n%d lines drilled with %d wrong moves, %d of %d questions answered correctly\n": "\n%d Varianten geübt mit %d falschen Zügen, %d von %d Fragen richtig beantwortet\n",
+	"Your move: ":                        "Dein Zug: ",
+	"Wrong, the solution was %s\n":       "Falsch, die Lösung war %s\n",
+	"Correct! The opponent replies %s\n": "Richtig! Der Gegner antwortet %s\n",
+	"Choose a puzzle file with -file":    "Wähle mit -file eine Datei mit Aufgaben",
+	"Failed to load your profile: %v\n":  "Dein Profil konnte nicht geladen werden: %v\n",
+	"No puzzles match your choice":       "Keine Aufgabe passt zu deiner Auswahl",
+	"Enter the solution moves in SAN, type %s to see the board or %s to hear the position again\n": "Gib die Lösungszüge in algebraischer Notation ein, %s zeigt das Brett, %s beschreibt die Stellung noch einmal\n",
+	"Skipping the line: %v\n":                                      "Variante übersprungen: %v\n",
+	"Skipping puzzle %s: %v\n":                                     "Aufgabe %s wird übersprungen: %v\n",
+	"\nPuzzle %d of %d (rating %d)\n":                              "\nAufgabe %d von %d (Wertung %d)\n",
+	"The last move was %s\n":                                       "Der letzte Zug war %s\n",
+	"%s to move\n":                                                 "%s am Zug\n",
+	"Failed to save your progress: %v\n":                           "Dein Fortschritt konnte nicht gespeichert werden: %v\n",
+	"\nSolved %d of %d puzzles (%d of %d puzzles solved so far)\n": "\n%d von %d Aufgaben gelöst (bisher %d von %d Aufgaben gelöst)\n",
+
+	// daily challenge and leaderboard
+	"Failed to load daily attempts: %v\n":             "Tagesversuche konnten nicht geladen werden: %v\n",
+	"You already played the daily challenge for %s\n": "Du hast die Tagesaufgabe vom %s schon gespielt\n",
+	"Failed to record daily attempt: %v\n":            "Tagesversuch konnte nicht gespeichert werden: %v\n",
+	"Failed to record daily result: %v\n":             "Tagesergebnis konnte nicht gespeichert werden: %v\n",
+	"Failed to load leaderboard: %v\n":                "Bestenliste konnte nicht geladen werden: %v\n",
+	"Failed to save score: %v\n":                      "Punktestand konnte nicht gespeichert werden: %v\n",
+	"New high score! Rank %d on the %s leaderboard\n": "Neuer Rekord! Platz %d in der Bestenliste %s\n",
+	"No scores yet": "Noch keine Ergebnisse",
+	"Name":          "Name",
+	"Score":         "Punkte",
+	"Level":         "Stufe",
+	"Time":          "Zeit",
+	"Seed":          "Seed",
+	"Date":          "Datum",
+	" (tampered)":   " (manipuliert)",
+
+	// play against the engine
+	"You play %s against the engine (strength %d)\n": "Du spielst %s gegen den Computer (Stärke %d)\n",
+	"Engine plays %s %s\n":                           "Der Computer spielt %s %s\n",
+	"You are in check":                               "Du stehst im Schach",
+	"Your move %s ":                                  "Dein Zug %s ",
+	"Penalty: %d points\n":                           "Strafe: %d Punkte\n",
+	"You resigned, the engine wins":                  "Du hast aufgegeben, der Computer gewinnt",
+	// where queries of the play command
+	`^where (?:is|are) (?:(?P<my>my)|(?P<white>the white)|(?P<black>the black)|(?P<enemy>the enemy)|the)?\s*(?P<piece>\pL+)\??$`: `^wo (?:ist|sind|steht|stehen) (?:(?P<my>meine?n?)|(?P<white>(?:der|die) weißen?)|(?P<black>(?:der|die) schwarzen?)|(?P<enemy>(?:der|die) gegnerischen?)|der|die)?\s*(?P<piece>\pL+)\??$`,
+	"I don't know the piece %q":  "Die Figur %q kenne ich nicht",
+	"Your %s":                    "%s (deine Seite)",
+	"The white %s":               "%s (Weiß)",
+	"The black %s":               "%s (Schwarz)",
+	"The enemy %s":               "%s (Gegner)",
+	"%s is not on the board":     "%s ist nicht auf dem Brett",
+	"%s is on %s":                "%s steht auf %s",
+	"%s are on %s":               "%s stehen auf %s",
+	"Can't start the game: %v\n": "Das Spiel kann nicht beginnen: %v\n",
+	"Can't play %q: %v\n":        "%q ist nicht spielbar: %v\n",
+	"Board shown %d times, penalty %d points\n":  "Brett %d Mal gezeigt, Strafe %d Punkte\n",
+	"Checkmate, the engine wins":                 "Schachmatt, der Computer gewinnt",
+	"Checkmate, you win!":                        "Schachmatt, du gewinnst!",
+	"Stalemate, it's a draw":                     "Patt, unentschieden",
+	"Unknown side %q, expected white or black\n": "Unbekannte Seite %q, erwartet wird white oder black\n",
+	`Enter moves in SAN (e4, Nf3, exd5, O-O, e8=Q).
+Other commands:
+  where is my queen?      where are the black pawns?
+  moves                   list the moves played so far
+  display                 show the board (costs penalty points)
+  resign                  give up the game
+`: `Gib Züge in algebraischer Notation ein (e4, Sf3, exd5, O-O, e8=D).
+Weitere Befehle:
+  wo ist meine Dame?      wo sind die schwarzen Bauern?
+  moves                   zeigt die bisherigen Züge
+  display                 zeigt das Brett (kostet Strafpunkte)
+  resign                  gibt die Partie auf
+`,
+}
@@ -0,0 +1,80 @@
+// Package locale Translations of the texts shown to the player.
+package locale
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+var ErrUnknownLanguage = errors.New("unknown language")
+
+// translations texts by their English original for every language but English.
+var translations = map[string]map[string]string{
+	game.German.Code: german,
+}
+
+// Catalogue Texts shown to the player in one language.
+type Catalogue struct {
+	language game.Language
+	// messages translations by their English original, texts without
+	// a translation are shown in English
+	messages map[string]string
+}
+
+// Default Get the English catalogue.
+func Default() *Catalogue {
+	return &Catalogue{language: game.English}
+}
+
+// New Get the catalogue of the language with the code (ex "de").
+func New(code string) (*Catalogue, error) {
+	language, found := game.LanguageByCode(code)
+	if !found {
+		return nil, fmt.Errorf("%w %q", ErrUnknownLanguage, code)
+	}
+
+	return &Catalogue{language: language, messages: translations[language.Code]}, nil
+}
+
+// Codes Get the codes of all languages with a catalogue.
+func Codes() []string {
+	codes := make([]string, 0, len(game.Languages))
+	for _, language := range game.Languages {
+		codes = append(codes, language.Code)
+	}
+
+	sort.Strings(codes)
+	return codes
+}
+
+// Language Get the language of the catalogue (piece names and notation letters).
+func (c *Catalogue) Language() game.Language {
+	return c.language
+}
+
+// Text Get the translation of an English text.
+func (c *Catalogue) Text(text string) string {
+	if translated, found := c.messages[text]; found {
+		return translated
+	}
+	return text
+}
+
+// Sprintf Formats the translation of an English format.
+func (c *Catalogue) Sprintf(format string, args ...any) string {
+	return fmt.Sprintf(c.Text(format), args...)
+}
+
+// Fprintf Writes the translation of an English format to w.
+func (c *Catalogue) Fprintf(w io.Writer, format string, args ...any) {
+	fmt.Fprintf(w, c.Text(format), args...)
+}
+
+// Fprintln Writes the translation of an English text on its own line to w.
+func (c *Catalogue) Fprintln(w io.Writer, text string) {
+	fmt.Fprintln(w, c.Text(text))
+}
@@ -0,0 +1,79 @@
+package locale
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+// verbs formatting verbs of a text (ex "%d", "%s").
+var verbs = regexp.MustCompile(`%[-+# 0-9.]*[a-zA-Z%]`)
+
+func TestTranslationsKeepVerbs(t *testing.T) {
+	for code, messages := range translations {
+		for english, translated := range messages {
+			expected := strings.Join(verbs.FindAllString(english, -1), " ")
+			if got := strings.Join(verbs.FindAllString(translated, -1), " "); got != expected {
+				t.Errorf("%s translation of %q has verbs %q instead of %q", code, english, got, expected)
+			}
+
+			if strings.HasSuffix(english, "\n") != strings.HasSuffix(translated, "\n") {
+				t.Errorf("%s translation of %q changes the line ending", code, english)
+			}
+		}
+	}
+}
+
+func TestTranslatedQueriesCompile(t *testing.T) {
+	for code, messages := range translations {
+		for english, translated := range messages {
+			if !strings.HasPrefix(english, "^") {
+				continue
+			}
+
+			query, err := regexp.Compile(translated)
+			if err != nil {
+				t.Errorf("%s translation of %q is not a regular expression: %v", code, english, err)
+				continue
+			}
+
+			expected := strings.Join(regexp.MustCompile(english).SubexpNames(), " ")
+			if got := strings.Join(query.SubexpNames(), " "); got != expected {
+				t.Errorf("%s translation of %q has groups %q instead of %q", code, english, got, expected)
+			}
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	catalogue, err := New("de")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if catalogue.Language().Code != game.German.Code {
+		t.Errorf("expected German but got %s", catalogue.Language().Name)
+	}
+
+	if text := catalogue.Sprintf("Penalty: %d points\n", 10); text != "Strafe: 10 Punkte\n" {
+		t.Errorf("unexpected translation %q", text)
+	}
+
+	// texts without a translation stay English
+	if text := catalogue.Text("no such text"); text != "no such text" {
+		t.Errorf("unexpected translation %q", text)
+	}
+
+	if _, err := New("xx"); !errors.Is(err, ErrUnknownLanguage) {
+		t.Errorf("expected ErrUnknownLanguage but got %v", err)
+	}
+}
+
+func TestDefault(t *testing.T) {
+	if text := Default().Sprintf("Penalty: %d points\n", 10); text != "Penalty: 10 points\n" {
+		t.Errorf("unexpected text %q", text)
+	}
+}
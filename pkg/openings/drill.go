@@ -0,0 +1,105 @@
+package openings
+
+import (
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+// Drill Plays the first moves of a line and lets the player recite the rest.
+type Drill struct {
+	// moves moves of the line checked when the drill started
+	moves    []game.Move
+	position *game.Position
+	// ply number of half-moves of the line played so far
+	ply      int
+	shown    int
+	Mistakes int
+}
+
+// NewDrill Starts a drill of the line that plays the first shown half-moves
+// (at least one move is left for the player). Fails if a move of the line is not legal.
+func NewDrill(line Line, shown int) (*Drill, error) {
+	moves, err := line.play()
+	if err != nil {
+		return nil, err
+	}
+
+	if shown > len(moves)-1 {
+		shown = len(moves) - 1
+	}
+	if shown < 0 {
+		shown = 0
+	}
+
+	d := &Drill{moves: moves, position: game.NewPosition()}
+	for d.ply < shown {
+		d.play(game.English)
+	}
+	d.shown = shown
+
+	return d, nil
+}
+
+// play Plays the next move of the line and returns it written in the language.
+func (d *Drill) play(language game.Language) string {
+	move := d.moves[d.ply]
+
+	san := d.position.LocalSAN(move, language)
+	d.position, _ = d.position.Play(move)
+	d.ply++
+	return san
+}
+
+// Shown Get the moves played for the player at the start of the drill,
+// written in the language.
+func (d *Drill) Shown(language game.Language) []string {
+	moves := make([]string, 0, d.shown)
+
+	position := game.NewPosition()
+	for _, move := range d.moves[:d.shown] {
+		moves = append(moves, position.LocalSAN(move, language))
+		position, _ = position.Play(move)
+	}
+	return moves
+}
+
+// Ply Get the number of half-moves played so far.
+func (d *Drill) Ply() int {
+	return d.ply
+}
+
+// Done Checks if the whole line has been played.
+func (d *Drill) Done() bool {
+	return d.ply >= len(d.moves)
+}
+
+// Position Get the current position of the drill.
+func (d *Drill) Position() *game.Position {
+	return d.position
+}
+
+// Answer Checks the player's move (written in the language) against the next
+// move of the line and plays the move of the line either way. Moves are compared
+// by what they do, so "Nf3" and "Ng1-f3" are the same. The expected move is
+// written in the language as well. Fails (without playing anything) if the text
+// is not a legal move or the line is over.
+func (d *Drill) Answer(text string, language game.Language) (correct bool, expected string, err error) {
+	if d.Done() {
+		return false, "", ErrNoMovesLeft
+	}
+
+	answer, err := d.position.ParseLocalSAN(text, language)
+	if err != nil {
+		return false, "", err
+	}
+
+	next := d.moves[d.ply]
+	correct = answer.From.Index() == next.From.Index() &&
+		answer.To.Index() == next.To.Index() &&
+		answer.Promotion == next.Promotion
+
+	if !correct {
+		d.Mistakes++
+	}
+
+	return correct, d.play(language), nil
+}
@@ -0,0 +1,126 @@
+// Package openings Loads opening lines and drills them move by move.
+package openings
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+	"github.com/AngelVI13/blind_chess/pkg/pgn"
+)
+
+var (
+	// ErrNoMovesLeft the whole line has been played.
+	ErrNoMovesLeft = errors.New("no moves left in the line")
+	// ErrSetUpPosition the line doesn't start from the starting position (ex a PGN with a FEN tag).
+	ErrSetUpPosition = errors.New("line doesn't start from the starting position")
+)
+
+// Line An opening line: its name and its moves in SAN from the starting position.
+type Line struct {
+	Name  string
+	Moves []string
+}
+
+// Load Reads the opening lines of a file. Files ending with .pgn hold one line
+// per game, other files hold one line per text line (see ParseMoveList).
+func Load(path string) ([]Line, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".pgn") {
+		return ParsePGN(file)
+	}
+	return ParseMoveList(file)
+}
+
+// ParsePGN Reads one line per game. The line is named after the Opening,
+// Variation or Event tag (whichever is there). Games from a set-up position
+// (with a FEN tag) fail with ErrSetUpPosition.
+func ParsePGN(r io.Reader) ([]Line, error) {
+	games, err := pgn.Parse(r)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]Line, 0, len(games))
+	for i, g := range games {
+		name := strings.TrimSpace(g.Tags["Opening"] + " " + g.Tags["Variation"])
+		if name == "" {
+			name = g.Tags["Event"]
+		}
+		if name == "" {
+			name = fmt.Sprintf("Line %d", i+1)
+		}
+		if _, found := g.Tags["FEN"]; found {
+			return nil, fmt.Errorf("%w: %s has a FEN tag", ErrSetUpPosition, name)
+		}
+
+		lines = append(lines, Line{Name: name, Moves: g.Moves})
+	}
+
+	return lines, validate(lines)
+}
+
+// ParseMoveList Reads one line per text line written as "Name: 1. e4 e5 2. Nf3"
+// (the name is optional). Empty lines and lines starting with # are skipped.
+func ParseMoveList(r io.Reader) ([]Line, error) {
+	var lines []Line
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		name := fmt.Sprintf("Line %d", len(lines)+1)
+		if before, after, found := strings.Cut(text, ":"); found {
+			name, text = strings.TrimSpace(before), after
+		}
+
+		games, err := pgn.Parse(strings.NewReader(text))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if len(games) == 0 || len(games[0].Moves) == 0 {
+			return nil, fmt.Errorf("%w: %s has no moves", pgn.ErrInvalidPGN, name)
+		}
+
+		lines = append(lines, Line{Name: name, Moves: games[0].Moves})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lines, validate(lines)
+}
+
+// validate Checks that the moves of every line are legal.
+func validate(lines []Line) error {
+	for _, line := range lines {
+		if _, err := line.play(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// play Get the moves of the line played from the starting position. Fails if a move is not legal.
+func (l Line) play() ([]game.Move, error) {
+	g := pgn.Game{Moves: l.Moves}
+	moves, _, err := g.Positions()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", l.Name, err)
+	}
+	return moves, nil
+}
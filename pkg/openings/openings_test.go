@@ -0,0 +1,116 @@
+package openings
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+func TestLoad(t *testing.T) {
+	lines, err := Load("testdata/repertoire.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{"Italian", "Queen's Gambit Declined", "Line 3"}
+	if len(lines) != len(names) {
+		t.Fatalf("expected %d lines but got %d", len(names), len(lines))
+	}
+	for i, name := range names {
+		if lines[i].Name != name {
+			t.Errorf("expected line %s but got %s", name, lines[i].Name)
+		}
+	}
+	if len(lines[0].Moves) != 9 {
+		t.Errorf("expected 9 moves in the Italian but got %d", len(lines[0].Moves))
+	}
+
+	lines, err = Load("testdata/repertoire.pgn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 2 || lines[0].Name != "Ruy Lopez Berlin Defence" || lines[1].Name != "Caro-Kann Advance" {
+		t.Errorf("unexpected lines %v", lines)
+	}
+}
+
+func TestLoadIllegalLine(t *testing.T) {
+	_, err := ParseMoveList(strings.NewReader("Broken: 1. e4 e5 2. Ke3"))
+	if !errors.Is(err, game.ErrIllegalMove) {
+		t.Errorf("expected ErrIllegalMove but got %v", err)
+	}
+
+	// a line from a set-up position is not an opening
+	pgn := "[Event \"Endgame\"]\n[FEN \"4k3/8/8/8/8/8/4P3/4K3 w - - 0 1\"]\n\n1. Kd2 Kd7 *\n"
+	if _, err := ParsePGN(strings.NewReader(pgn)); !errors.Is(err, ErrSetUpPosition) {
+		t.Errorf("expected ErrSetUpPosition but got %v", err)
+	}
+
+	// lines built by hand are checked when the drill starts
+	if _, err := NewDrill(Line{Name: "Broken", Moves: []string{"e4", "Ke3"}}, 1); !errors.Is(err, game.ErrIllegalMove) {
+		t.Errorf("expected ErrIllegalMove for a drill of an illegal line but got %v", err)
+	}
+}
+
+func TestDrill(t *testing.T) {
+	line := Line{Name: "Italian", Moves: []string{"e4", "e5", "Nf3", "Nc6", "Bc4"}}
+	drill, err := NewDrill(line, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Join(drill.Shown(game.English), " ") != "e4 e5" || drill.Ply() != 2 {
+		t.Fatalf("expected the drill to show e4 e5")
+	}
+
+	if _, _, err := drill.Answer("Nf4", game.English); !errors.Is(err, game.ErrIllegalMove) {
+		t.Errorf("expected ErrIllegalMove but got %v", err)
+	}
+
+	// long notation describes the same move
+	if correct, _, err := drill.Answer("Ng1-f3", game.English); err != nil || !correct {
+		t.Errorf("expected Ng1-f3 to be correct (%v)", err)
+	}
+
+	correct, expected, err := drill.Answer("d6", game.English)
+	if err != nil || correct || expected != "Nc6" || drill.Mistakes != 1 {
+		t.Errorf("expected d6 to be a mistake (Nc6 expected), got %t %s %v", correct, expected, err)
+	}
+
+	drill.Answer("Bc4", game.English)
+	if !drill.Done() {
+		t.Errorf("expected the drill to be done")
+	}
+	if _, _, err := drill.Answer("Bc4", game.English); !errors.Is(err, ErrNoMovesLeft) {
+		t.Errorf("expected ErrNoMovesLeft but got %v", err)
+	}
+
+	// at least one move is left for the player
+	if drill, _ := NewDrill(line, 10); drill.Ply() != 4 {
+		t.Errorf("expected 4 shown moves but got %d", drill.Ply())
+	}
+}
+
+func TestDrillGerman(t *testing.T) {
+	line := Line{Name: "Italian", Moves: []string{"e4", "e5", "Nf3", "Nc6", "Bc4"}}
+	drill, err := NewDrill(line, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if correct, _, err := drill.Answer("Sf3", game.German); err != nil || !correct {
+		t.Errorf("expected Sf3 to be correct (%v)", err)
+	}
+
+	correct, expected, err := drill.Answer("d6", game.German)
+	if err != nil || correct || expected != "Sc6" {
+		t.Errorf("expected d6 to be a mistake (Sc6 expected), got %t %s %v", correct, expected, err)
+	}
+
+	drill, _ = NewDrill(line, 3)
+	if shown := strings.Join(drill.Shown(game.German), " "); shown != "e4 e5 Sf3" {
+		t.Errorf("expected the drill to show e4 e5 Sf3 but got %s", shown)
+	}
+}
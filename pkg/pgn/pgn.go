@@ -0,0 +1,258 @@
+// Package pgn Reads games written in Portable Game Notation.
+package pgn
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+// ErrInvalidPGN the text is not a game in Portable Game Notation.
+var ErrInvalidPGN = errors.New("invalid PGN")
+
+// results game termination markers that end the move text of a game.
+var results = map[string]struct{}{
+	"1-0":     {},
+	"0-1":     {},
+	"1/2-1/2": {},
+	"*":       {},
+}
+
+// Game A game read from PGN: its tags (ex "White", "Event") and its moves in SAN.
+type Game struct {
+	Tags   map[string]string
+	Moves  []string
+	Result string
+}
+
+// Title Get a short description of the game (ex "Morphy - Duke Karl / Count Isouard, Paris 1858").
+func (g Game) Title() string {
+	title := fmt.Sprintf("%s - %s", g.tag("White"), g.tag("Black"))
+
+	place := strings.Trim(g.Tags["Site"], "?")
+	year := strings.Split(g.Tags["Date"], ".")[0]
+	if year == "????" {
+		year = ""
+	}
+
+	if details := strings.TrimSpace(place + " " + year); details != "" {
+		title += ", " + details
+	}
+	return title
+}
+
+func (g Game) tag(name string) string {
+	if value, found := g.Tags[name]; found && value != "" {
+		return value
+	}
+	return "?"
+}
+
+// Start Get the position the game starts from (the FEN tag or the standard starting position).
+func (g Game) Start() (*game.Position, error) {
+	fen, found := g.Tags["FEN"]
+	if !found {
+		return game.NewPosition(), nil
+	}
+	return game.ParseFEN(fen)
+}
+
+// Positions Plays the moves of the game and gets the moves and the position
+// after each of them. Fails if a move is not legal.
+func (g Game) Positions() ([]game.Move, []*game.Position, error) {
+	position, err := g.Start()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	moves := make([]game.Move, 0, len(g.Moves))
+	positions := make([]*game.Position, 0, len(g.Moves))
+
+	for ply, san := range g.Moves {
+		move, err := position.ParseSAN(san)
+		if err != nil {
+			return nil, nil, fmt.Errorf("move %d (%s): %w", ply/2+1, san, err)
+		}
+
+		position, err = position.Play(move)
+		if err != nil {
+			return nil, nil, fmt.Errorf("move %d (%s): %w", ply/2+1, san, err)
+		}
+
+		moves = append(moves, move)
+		positions = append(positions, position)
+	}
+
+	return moves, positions, nil
+}
+
+// Load Reads all games of a PGN file.
+func Load(path string) ([]Game, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file)
+}
+
+// Parse Reads all games from PGN text. Comments, variations and numeric
+// annotation glyphs are skipped.
+func Parse(r io.Reader) ([]Game, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var games []Game
+	current := Game{Tags: map[string]string{}}
+	var moveText []string
+
+	finish := func() error {
+		moves, result, err := parseMoveText(strings.Join(moveText, "\n"))
+		if err != nil {
+			return fmt.Errorf("game %d: %w", len(games)+1, err)
+		}
+
+		current.Moves = moves
+		current.Result = result
+		if result == "" {
+			current.Result = "*"
+		}
+
+		games = append(games, current)
+		current = Game{Tags: map[string]string{}}
+		moveText = nil
+		return nil
+	}
+
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "%") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			// tags after move text start the next game
+			if len(moveText) > 0 {
+				if err := finish(); err != nil {
+					return nil, err
+				}
+			}
+
+			name, value, err := parseTag(line)
+			if err != nil {
+				return nil, err
+			}
+			current.Tags[name] = value
+			continue
+		}
+
+		moveText = append(moveText, line)
+	}
+
+	if len(moveText) > 0 || len(current.Tags) > 0 {
+		if err := finish(); err != nil {
+			return nil, err
+		}
+	}
+
+	return games, nil
+}
+
+// parseTag Splits a tag pair like `[White "Morphy, Paul"]`.
+func parseTag(line string) (string, string, error) {
+	if !strings.HasSuffix(line, "]") {
+		return "", "", fmt.Errorf("%w: unterminated tag %s", ErrInvalidPGN, line)
+	}
+
+	inner := strings.TrimSpace(line[1 : len(line)-1])
+	name, value, found := strings.Cut(inner, " ")
+	value = strings.TrimSpace(value)
+	if !found || len(value) < 2 || value[0] != '"' || value[len(value)-1] != '"' {
+		return "", "", fmt.Errorf("%w: bad tag %s", ErrInvalidPGN, line)
+	}
+
+	value = strings.ReplaceAll(value[1:len(value)-1], `\"`, `"`)
+	return name, value, nil
+}
+
+// parseMoveText Get the moves (in SAN) and the result of the move text of a game.
+func parseMoveText(text string) ([]string, string, error) {
+	var moves []string
+	result := ""
+	depth := 0 // nesting of variations
+
+	for len(text) > 0 {
+		char := rune(text[0])
+
+		switch {
+		case unicode.IsSpace(char):
+			text = text[1:]
+			continue
+		case char == '{':
+			end := strings.IndexByte(text, '}')
+			if end < 0 {
+				return nil, "", fmt.Errorf("%w: unterminated comment", ErrInvalidPGN)
+			}
+			text = text[end+1:]
+			continue
+		case char == ';':
+			end := strings.IndexByte(text, '\n')
+			if end < 0 {
+				end = len(text) - 1
+			}
+			text = text[end+1:]
+			continue
+		case char == '(':
+			depth++
+			text = text[1:]
+			continue
+		case char == ')':
+			if depth == 0 {
+				return nil, "", fmt.Errorf("%w: unbalanced variation", ErrInvalidPGN)
+			}
+			depth--
+			text = text[1:]
+			continue
+		}
+
+		end := strings.IndexFunc(text, func(r rune) bool {
+			return unicode.IsSpace(r) || strings.ContainsRune("{;()", r)
+		})
+		if end < 0 {
+			end = len(text)
+		}
+		token := text[:end]
+		text = text[end:]
+
+		if depth > 0 || strings.HasPrefix(token, "$") {
+			continue
+		}
+
+		if _, found := results[token]; found {
+			result = token
+			continue
+		}
+
+		// move numbers ("12." or "12...") may be glued to the move ("12.Nf3")
+		if dot := strings.IndexByte(token, '.'); dot > 0 && strings.Trim(token[:dot], "0123456789") == "" {
+			token = strings.TrimLeft(token[dot:], ".")
+		}
+		if token != "" {
+			moves = append(moves, token)
+		}
+	}
+
+	if depth != 0 {
+		return nil, "", fmt.Errorf("%w: unbalanced variation", ErrInvalidPGN)
+	}
+
+	return moves, result, nil
+}
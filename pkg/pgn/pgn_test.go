@@ -0,0 +1,71 @@
+package pgn
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	games, err := Load("testdata/opera.pgn")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(games) != 2 {
+		t.Fatalf("expected 2 games but got %d", len(games))
+	}
+
+	opera := games[0]
+	if title := opera.Title(); title != "Paul Morphy - Duke Karl / Count Isouard, Paris FRA 1858" {
+		t.Errorf("unexpected title %s", title)
+	}
+	if len(opera.Moves) != 33 || opera.Result != "1-0" {
+		t.Errorf("expected 33 moves and 1-0 but got %d moves and %s", len(opera.Moves), opera.Result)
+	}
+
+	moves, positions, err := opera.Positions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(moves) != 33 || !positions[len(positions)-1].Checkmate() {
+		t.Errorf("expected the opera game to end in checkmate")
+	}
+
+	// the variation, the comments and the annotation glyph are skipped
+	immortal := games[1]
+	expected := "e4 e5 f4 exf4 Bc4 Qh4+ Kf1 b5 Bxb5 Nf6 Nf3 Qh6"
+	if got := strings.Join(immortal.Moves, " "); got != expected {
+		t.Errorf("expected moves %s but got %s", expected, got)
+	}
+	if immortal.Result != "*" {
+		t.Errorf("expected result * but got %s", immortal.Result)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		"[White \"Morphy\"\n1. e4 *",
+		"[White Morphy]\n1. e4 *",
+		"1. e4 {unterminated",
+		"1. e4 (1. d4 *",
+		"1. e4 ) *",
+	}
+
+	for _, text := range tests {
+		if _, err := Parse(strings.NewReader(text)); !errors.Is(err, ErrInvalidPGN) {
+			t.Errorf("expected ErrInvalidPGN for %q but got %v", text, err)
+		}
+	}
+}
+
+func TestPositionsIllegalMove(t *testing.T) {
+	games, err := Parse(strings.NewReader("[FEN \"4k3/8/8/8/8/8/8/4K3 w - - 0 1\"]\n\n1. Kd2 Ke7 2. Kd4 *"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err := games[0].Positions(); err == nil || !strings.Contains(err.Error(), "move 2 (Kd4)") {
+		t.Errorf("expected an error for move 2 but got %v", err)
+	}
+}
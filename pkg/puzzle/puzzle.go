@@ -0,0 +1,247 @@
+// Package puzzle Loads tactics puzzles in the Lichess CSV format and checks solutions.
+package puzzle
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+// ErrInvalidPuzzle the CSV record is not a puzzle.
+var ErrInvalidPuzzle = errors.New("invalid puzzle")
+
+// Columns of the Lichess puzzle CSV (PuzzleId,FEN,Moves,Rating,RatingDeviation,
+// Popularity,NbPlays,Themes,GameUrl,OpeningTags).
+const (
+	idColumn     = 0
+	fenColumn    = 1
+	movesColumn  = 2
+	ratingColumn = 3
+	themesColumn = 7
+)
+
+// Puzzle A tactics puzzle. The first move is the opponent's move that sets up
+// the puzzle, the player's moves and the opponent's replies alternate after it.
+type Puzzle struct {
+	ID     string
+	FEN    string
+	Moves  []string // in UCI notation
+	Rating int
+	Themes []string
+}
+
+// Load Reads the puzzles of a Lichess puzzle CSV file that pass the filter.
+func Load(path string, filter Filter) ([]Puzzle, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return Parse(file, filter)
+}
+
+// Parse Reads the puzzles in the Lichess CSV format that pass the filter, the
+// others are dropped as they are read. A header line is skipped. The moves are
+// only checked once a puzzle is started (see Start).
+func Parse(r io.Reader, filter Filter) ([]Puzzle, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	var puzzles []Puzzle
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if line == 1 && record[idColumn] == "PuzzleId" {
+			continue
+		}
+
+		puzzle, err := parseRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if filter.Matches(puzzle) {
+			puzzles = append(puzzles, puzzle)
+		}
+	}
+
+	return puzzles, nil
+}
+
+func parseRecord(record []string) (Puzzle, error) {
+	if len(record) <= ratingColumn {
+		return Puzzle{}, fmt.Errorf("%w: expected at least %d columns", ErrInvalidPuzzle, ratingColumn+1)
+	}
+
+	rating, err := strconv.Atoi(record[ratingColumn])
+	if err != nil {
+		return Puzzle{}, fmt.Errorf("%w: bad rating %q", ErrInvalidPuzzle, record[ratingColumn])
+	}
+
+	puzzle := Puzzle{
+		ID:     record[idColumn],
+		FEN:    record[fenColumn],
+		Moves:  strings.Fields(record[movesColumn]),
+		Rating: rating,
+	}
+	if len(record) > themesColumn {
+		puzzle.Themes = strings.Fields(record[themesColumn])
+	}
+
+	if len(puzzle.Moves) < 2 {
+		return Puzzle{}, fmt.Errorf("%w: %s needs a setup move and a solution", ErrInvalidPuzzle, puzzle.ID)
+	}
+
+	return puzzle, nil
+}
+
+// HasTheme Checks if the puzzle is tagged with the theme (ex "fork", "mateIn2").
+func (p Puzzle) HasTheme(theme string) bool {
+	for _, t := range p.Themes {
+		if strings.EqualFold(t, theme) {
+			return true
+		}
+	}
+	return false
+}
+
+// Filter Which puzzles to choose. Zero values don't filter.
+type Filter struct {
+	MinRating int
+	MaxRating int
+	Theme     string
+}
+
+// Matches Checks if the puzzle passes the filter.
+func (f Filter) Matches(p Puzzle) bool {
+	if f.MinRating > 0 && p.Rating < f.MinRating {
+		return false
+	}
+	if f.MaxRating > 0 && p.Rating > f.MaxRating {
+		return false
+	}
+	return f.Theme == "" || p.HasTheme(f.Theme)
+}
+
+// Start Sets up the puzzle by playing the opponent's first move. Fails with
+// ErrInvalidPuzzle if the position or one of the moves is not legal.
+func (p Puzzle) Start() (*Attempt, error) {
+	position, err := game.ParseFEN(p.FEN)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPuzzle, err)
+	}
+
+	attempt := &Attempt{puzzle: p, position: position}
+	if _, err := attempt.playNext(game.English); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPuzzle, err)
+	}
+
+	// every solution move needs a legal position to be played in
+	check := *attempt
+	for !check.Done() {
+		if _, err := check.playNext(game.English); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPuzzle, err)
+		}
+	}
+
+	return attempt, nil
+}
+
+// Attempt The player's try at solving a puzzle.
+type Attempt struct {
+	puzzle   Puzzle
+	position *game.Position
+	// next index of the next move of the puzzle
+	next   int
+	failed bool
+}
+
+// playNext Plays the next move of the puzzle and gets it in SAN.
+func (a *Attempt) playNext(language game.Language) (string, error) {
+	move, err := a.position.ParseUCI(a.puzzle.Moves[a.next])
+	if err != nil {
+		return "", err
+	}
+
+	san := a.position.LocalSAN(move, language)
+	a.position, err = a.position.Play(move)
+	if err != nil {
+		return "", err
+	}
+	a.next++
+	return san, nil
+}
+
+// Position Get the position the player has to find a move in.
+func (a *Attempt) Position() *game.Position {
+	return a.position
+}
+
+// SetupMove Get the opponent's move that set up the puzzle in SAN with the piece letters of the language.
+func (a *Attempt) SetupMove(language game.Language) string {
+	position, _ := game.ParseFEN(a.puzzle.FEN)
+	move, _ := position.ParseUCI(a.puzzle.Moves[0])
+	return position.LocalSAN(move, language)
+}
+
+// Done Checks if all moves of the puzzle were played (or the attempt failed).
+func (a *Attempt) Done() bool {
+	return a.failed || a.next >= len(a.puzzle.Moves)
+}
+
+// Solved Checks if the player found every move.
+func (a *Attempt) Solved() bool {
+	return a.Done() && !a.failed
+}
+
+// Answer Checks the player's move (in SAN with the piece letters of the language).
+// A correct move is played together with the opponent's reply (empty if the
+// puzzle is solved). A wrong move fails the attempt and gets the solution move
+// instead. A checkmate is always correct. Moves are returned in the language.
+// Fails if the text is not a legal move or the attempt is over.
+func (a *Attempt) Answer(text string, language game.Language) (correct bool, reply string, err error) {
+	if a.Done() {
+		return false, "", fmt.Errorf("puzzle %s is over", a.puzzle.ID)
+	}
+
+	move, err := a.position.ParseLocalSAN(text, language)
+	if err != nil {
+		return false, "", err
+	}
+
+	expected, _ := a.position.ParseUCI(a.puzzle.Moves[a.next])
+	if move.UCI() != expected.UCI() {
+		next, _ := a.position.Play(move)
+		if !next.Checkmate() {
+			a.failed = true
+			return false, a.position.LocalSAN(expected, language), nil
+		}
+
+		// another mate ends the puzzle just as well
+		a.position = next
+		a.next = len(a.puzzle.Moves)
+		return true, "", nil
+	}
+
+	if _, err := a.playNext(language); err != nil {
+		return false, "", err
+	}
+	if a.Done() {
+		return true, "", nil
+	}
+
+	reply, err = a.playNext(language)
+	return true, reply, err
+}
@@ -0,0 +1,149 @@
+package puzzle
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+func TestLoad(t *testing.T) {
+	puzzles, err := Load("testdata/puzzles.csv", Filter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(puzzles) != 2 {
+		t.Fatalf("expected 2 puzzles but got %d", len(puzzles))
+	}
+
+	fork := puzzles[1]
+	if fork.ID != "t0002" || fork.Rating != 1240 || !fork.HasTheme("Fork") || len(fork.Moves) != 4 {
+		t.Errorf("unexpected puzzle %+v", fork)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		"x1,6k1/5ppp/8/8/8/8/r4PPP/3R2K1 b - - 0 1,a2a3 d1d8,high",
+		"x2,6k1/5ppp/8/8/8/8/r4PPP/3R2K1 b - - 0 1,a2a3,900",
+		"x5,6k1",
+	}
+
+	for _, text := range tests {
+		if _, err := Parse(strings.NewReader(text), Filter{}); !errors.Is(err, ErrInvalidPuzzle) {
+			t.Errorf("expected ErrInvalidPuzzle for %q but got %v", text, err)
+		}
+	}
+}
+
+func TestStartErrors(t *testing.T) {
+	// illegal moves and positions are only found once the puzzle is started
+	tests := []string{
+		"x3,6k1/5ppp/8/8/8/8/r4PPP/3R2K1 b - - 0 1,a2a3 d1d9,900",
+		"x4,not a fen,a2a3 d1d8,900",
+		"x6,6k1/5ppp/8/8/8/8/r4PPP/3R2K1 b - - 0 1,a2a3 d1d8 g8g7,900",
+	}
+
+	for _, text := range tests {
+		puzzles, err := Parse(strings.NewReader(text), Filter{})
+		if err != nil || len(puzzles) != 1 {
+			t.Errorf("expected %q to be read but got %v", text, err)
+			continue
+		}
+
+		if _, err := puzzles[0].Start(); !errors.Is(err, ErrInvalidPuzzle) {
+			t.Errorf("expected ErrInvalidPuzzle starting %q but got %v", text, err)
+		}
+	}
+}
+
+func TestLoadFilter(t *testing.T) {
+	tests := []struct {
+		filter   Filter
+		expected int
+	}{
+		{Filter{}, 2},
+		{Filter{MinRating: 1000}, 1},
+		{Filter{MaxRating: 1000}, 1},
+		{Filter{Theme: "mateIn1"}, 1},
+		{Filter{MinRating: 1000, Theme: "mateIn1"}, 0},
+	}
+
+	for _, test := range tests {
+		selected, err := Load("testdata/puzzles.csv", test.filter)
+		if err != nil || len(selected) != test.expected {
+			t.Errorf("expected %d puzzles for %+v but got %d (%v)", test.expected, test.filter, len(selected), err)
+		}
+	}
+}
+
+func TestAttempt(t *testing.T) {
+	puzzles, _ := Load("testdata/puzzles.csv", Filter{})
+
+	attempt, err := puzzles[1].Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if setup := attempt.SetupMove(game.English); setup != "Qd7" {
+		t.Errorf("expected the setup move Qd7 but got %s", setup)
+	}
+
+	if _, _, err := attempt.Answer("Nf7", game.English); !errors.Is(err, game.ErrIllegalMove) {
+		t.Errorf("expected ErrIllegalMove but got %v", err)
+	}
+
+	correct, reply, err := attempt.Answer("Nf6+", game.English)
+	if err != nil || !correct || reply != "Kg7" {
+		t.Fatalf("expected Nf6+ to be correct with the reply Kg7, got %t %s %v", correct, reply, err)
+	}
+
+	correct, _, _ = attempt.Answer("Nxd7", game.English)
+	if !correct || !attempt.Solved() {
+		t.Errorf("expected the puzzle to be solved")
+	}
+
+	// a wrong move fails the attempt and shows the solution
+	attempt, _ = puzzles[1].Start()
+	correct, solution, _ := attempt.Answer("Nc5", game.English)
+	if correct || solution != "Nf6+" || !attempt.Done() || attempt.Solved() {
+		t.Errorf("expected Nc5 to fail the puzzle with the solution Nf6+, got %s", solution)
+	}
+}
+
+func TestAttemptGerman(t *testing.T) {
+	puzzles, _ := Load("testdata/puzzles.csv", Filter{})
+
+	attempt, err := puzzles[1].Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if setup := attempt.SetupMove(game.German); setup != "Dd7" {
+		t.Errorf("expected the setup move Dd7 but got %s", setup)
+	}
+
+	correct, reply, err := attempt.Answer("Sf6+", game.German)
+	if err != nil || !correct || reply != "Kg7" {
+		t.Fatalf("expected Sf6+ to be correct with the reply Kg7, got %t %s %v", correct, reply, err)
+	}
+
+	// English letters are not German ones (S is the German knight)
+	attempt, _ = puzzles[1].Start()
+	if _, _, err := attempt.Answer("Nf6+", game.German); err == nil {
+		t.Errorf("expected Nf6+ to be rejected in German")
+	}
+}
+
+func TestAttemptOtherMate(t *testing.T) {
+	// both Rd8# and Re8# mate, the puzzle only lists Rd8#
+	p := Puzzle{ID: "mate", FEN: "6k1/5ppp/8/8/8/8/r4PPP/3RR1K1 b - - 0 1", Moves: []string{"a2a3", "d1d8"}}
+	attempt, err := p.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if correct, _, _ := attempt.Answer("Re8#", game.English); !correct || !attempt.Solved() {
+		t.Errorf("expected any mate to solve the puzzle")
+	}
+}
@@ -0,0 +1,66 @@
+package store
+
+import (
+	"errors"
+	"path/filepath"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+const dailyFile = "daily.json"
+
+var ErrAlreadyPlayed = errors.New("daily challenge was already played")
+
+// dailyAttempt Daily challenge attempt, unfinished attempts still count.
+type dailyAttempt struct {
+	Result   game.DailyResult
+	Finished bool
+}
+
+// DailyLog Record of daily challenge attempts (one per day).
+type DailyLog struct {
+	path     string
+	Attempts map[string]dailyAttempt
+}
+
+// LoadDailyLog Loads the daily log from the given data directory.
+func LoadDailyLog(dir string) (*DailyLog, error) {
+	log := &DailyLog{
+		path:     filepath.Join(dir, dailyFile),
+		Attempts: map[string]dailyAttempt{},
+	}
+
+	if err := loadJSON(log.path, log); err != nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+// Attempt Get the result of the attempt on the given date (ex "2022-07-30").
+// finished is false if the game was abandoned.
+func (l *DailyLog) Attempt(date string) (result game.DailyResult, finished, found bool) {
+	attempt, found := l.Attempts[date]
+	return attempt.Result, attempt.Finished, found
+}
+
+// Begin Records the start of the attempt on the given date. It's recorded before the game
+// so quitting a bad game doesn't give another attempt.
+func (l *DailyLog) Begin(date string) error {
+	if _, found := l.Attempts[date]; found {
+		return ErrAlreadyPlayed
+	}
+
+	l.Attempts[date] = dailyAttempt{Result: game.DailyResult{Date: date}}
+	return saveJSON(l.path, l)
+}
+
+// Finish Records the result of the attempt started with Begin.
+func (l *DailyLog) Finish(result game.DailyResult) error {
+	attempt, found := l.Attempts[result.Date]
+	if found && attempt.Finished {
+		return ErrAlreadyPlayed
+	}
+
+	l.Attempts[result.Date] = dailyAttempt{Result: result, Finished: true}
+	return saveJSON(l.path, l)
+}
@@ -0,0 +1,61 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+func TestDailyLogOneAttemptPerDay(t *testing.T) {
+	dir := t.TempDir()
+
+	log, err := LoadDailyLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := log.Begin("2022-07-30"); err != nil {
+		t.Fatal(err)
+	}
+
+	result := game.DailyResult{Date: "2022-07-30", Score: 12, Level: 2, Duration: time.Minute}
+	if err := log.Finish(result); err != nil {
+		t.Fatal(err)
+	}
+
+	// reload from disk to check the attempt was saved
+	log, err = LoadDailyLog(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := log.Begin("2022-07-30"); err != ErrAlreadyPlayed {
+		t.Errorf("expected ErrAlreadyPlayed for a second attempt but got %v", err)
+	}
+
+	saved, finished, found := log.Attempt("2022-07-30")
+	if !found || !finished || saved != result {
+		t.Errorf("expected finished attempt %+v but got %+v (finished=%t)", result, saved, finished)
+	}
+
+	if err := log.Begin("2022-07-31"); err != nil {
+		t.Errorf("expected new attempt on the next day but got %v", err)
+	}
+}
+
+func TestDailyLogAbandonedAttempt(t *testing.T) {
+	dir := t.TempDir()
+
+	log, _ := LoadDailyLog(dir)
+	log.Begin("2022-07-30")
+
+	log, _ = LoadDailyLog(dir)
+	if err := log.Begin("2022-07-30"); err != ErrAlreadyPlayed {
+		t.Errorf("expected abandoned attempt to count, got %v", err)
+	}
+
+	if _, finished, _ := log.Attempt("2022-07-30"); finished {
+		t.Errorf("expected abandoned attempt to be unfinished")
+	}
+}
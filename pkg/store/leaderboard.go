@@ -0,0 +1,169 @@
+package store
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+const (
+	leaderboardFile    = "leaderboard.json"
+	leaderboardKeyFile = "leaderboard.key"
+
+	// MaxEntries number of entries kept per leaderboard table.
+	MaxEntries = 10
+)
+
+// Entry Leaderboard entry. MAC is an HMAC over the entry and the game transcript
+// so that entries edited by hand can be detected.
+type Entry struct {
+	Name       string
+	Score      int
+	Level      int
+	Duration   time.Duration
+	Seed       int64
+	Date       time.Time
+	Transcript game.Transcript
+	MAC        string
+}
+
+func NewEntry(name string, g *game.Game) Entry {
+	return Entry{
+		Name:       name,
+		Score:      g.Points(),
+		Level:      g.Level(),
+		Duration:   g.Duration().Round(time.Second),
+		Seed:       g.Seed(),
+		Date:       time.Now().UTC().Truncate(time.Second),
+		Transcript: g.Transcript(),
+	}
+}
+
+// mac Compute the HMAC of the entry as part of the given table.
+func (e Entry) mac(key []byte, table string) string {
+	hash := hmac.New(sha256.New, key)
+	fmt.Fprintf(
+		hash,
+		"%s\n%s\n%d\n%d\n%d\n%d\n%s\n",
+		table,
+		e.Name,
+		e.Score,
+		e.Level,
+		e.Duration,
+		e.Seed,
+		e.Date.Format(time.RFC3339),
+	)
+	hash.Write([]byte(e.Transcript.String()))
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// Leaderboard High score tables, one per game configuration (see game.Config.Key).
+type Leaderboard struct {
+	path   string
+	key    []byte
+	Tables map[string][]Entry
+}
+
+// LoadLeaderboard Loads the leaderboard from the given data directory. The secret
+// used to sign entries is created on first use.
+func LoadLeaderboard(dir string) (*Leaderboard, error) {
+	key, err := loadKey(filepath.Join(dir, leaderboardKeyFile))
+	if err != nil {
+		return nil, err
+	}
+
+	l := &Leaderboard{
+		path:   filepath.Join(dir, leaderboardFile),
+		key:    key,
+		Tables: map[string][]Entry{},
+	}
+
+	if err := loadJSON(l.path, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// loadKey Reads the signing key from path or creates a new random one.
+func loadKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		return hex.DecodeString(string(data))
+	}
+
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	key := make([]byte, sha256.Size)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	return key, os.WriteFile(path, []byte(hex.EncodeToString(key)), 0o600)
+}
+
+// Add Signs the entry and adds it to the given table. Returns the 1-based rank of
+// the entry or 0 if the score is too low to make it to the table.
+func (l *Leaderboard) Add(table string, entry Entry) (int, error) {
+	entry.MAC = entry.mac(l.key, table)
+
+	entries := append(l.Tables[table], entry)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Score > entries[j].Score
+	})
+
+	rank := 0
+	for idx := range entries {
+		if entries[idx].MAC == entry.MAC {
+			rank = idx + 1
+			break
+		}
+	}
+
+	if len(entries) > MaxEntries {
+		entries = entries[:MaxEntries]
+	}
+
+	if rank > MaxEntries {
+		rank = 0
+	}
+
+	l.Tables[table] = entries
+	return rank, saveJSON(l.path, l)
+}
+
+func (l *Leaderboard) Table(table string) []Entry {
+	return l.Tables[table]
+}
+
+// TableNames Get the sorted names of all tables.
+func (l *Leaderboard) TableNames() []string {
+	names := make([]string, 0, len(l.Tables))
+	for name := range l.Tables {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// Verify Checks that the entry of the given table wasn't edited after it was added.
+func (l *Leaderboard) Verify(table string, entry Entry) bool {
+	return hmac.Equal([]byte(entry.MAC), []byte(entry.mac(l.key, table)))
+}
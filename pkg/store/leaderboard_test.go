@@ -0,0 +1,103 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AngelVI13/blind_chess/pkg/game"
+)
+
+func testEntry(name string, score int) Entry {
+	return Entry{
+		Name:       name,
+		Score:      score,
+		Level:      score/game.QuestionsPerLevel + 1,
+		Duration:   time.Minute,
+		Seed:       42,
+		Date:       time.Date(2022, 7, 30, 0, 0, 0, 0, time.UTC),
+		Transcript: game.Transcript{"setup sudden seed 42", "correct"},
+	}
+}
+
+func TestLeaderboardAdd(t *testing.T) {
+	dir := t.TempDir()
+
+	l, err := LoadLeaderboard(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < MaxEntries; i++ {
+		l.Add("sudden", testEntry("low", i))
+	}
+
+	rank, err := l.Add("sudden", testEntry("best", 50))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rank != 1 {
+		t.Errorf("expected best score to rank 1 but got %d", rank)
+	}
+
+	rank, _ = l.Add("sudden", testEntry("worst", -1))
+	if rank != 0 {
+		t.Errorf("expected worst score not to make the table but got rank %d", rank)
+	}
+
+	l.Add("practice", testEntry("other", 1))
+
+	// reload to check entries and key were saved
+	l, err = LoadLeaderboard(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table := l.Table("sudden")
+	if len(table) != MaxEntries || table[0].Name != "best" {
+		t.Fatalf("expected %d entries led by 'best' but got %+v", MaxEntries, table)
+	}
+
+	for _, entry := range table {
+		if !l.Verify("sudden", entry) {
+			t.Errorf("expected entry %s (%d) to verify", entry.Name, entry.Score)
+		}
+	}
+
+	if names := l.TableNames(); strings.Join(names, ",") != "practice,sudden" {
+		t.Errorf("unexpected table names %v", names)
+	}
+}
+
+func TestLeaderboardTampered(t *testing.T) {
+	dir := t.TempDir()
+
+	l, _ := LoadLeaderboard(dir)
+	l.Add("sudden", testEntry("player", 7))
+
+	path := filepath.Join(dir, leaderboardFile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edited := strings.Replace(string(data), `"Score": 7`, `"Score": 70`, 1)
+	if edited == string(data) {
+		t.Fatal("failed to edit the score in the leaderboard file")
+	}
+	os.WriteFile(path, []byte(edited), 0o644)
+
+	l, _ = LoadLeaderboard(dir)
+	entry := l.Table("sudden")[0]
+	if l.Verify("sudden", entry) {
+		t.Errorf("expected edited entry to fail verification")
+	}
+
+	// moving an entry to another table is detected as well
+	entry.Score = 7
+	if !l.Verify("sudden", entry) || l.Verify("practice", entry) {
+		t.Errorf("expected entry to verify only in its own table")
+	}
+}
@@ -0,0 +1,62 @@
+package store
+
+import (
+	"path/filepath"
+)
+
+const profileFile = "profile.json"
+
+// PuzzleRecord The player's attempts at one puzzle.
+type PuzzleRecord struct {
+	Attempts int
+	Solved   bool
+	// Date of the last attempt (ex "2022-07-30")
+	Date string
+}
+
+// Profile The player's progress across sessions.
+type Profile struct {
+	path    string
+	Puzzles map[string]PuzzleRecord
+}
+
+// LoadProfile Loads the player profile from the given data directory.
+func LoadProfile(dir string) (*Profile, error) {
+	profile := &Profile{
+		path:    filepath.Join(dir, profileFile),
+		Puzzles: map[string]PuzzleRecord{},
+	}
+
+	if err := loadJSON(profile.path, profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// RecordPuzzle Records an attempt at the puzzle on the given date. A puzzle
+// solved once stays solved.
+func (p *Profile) RecordPuzzle(id string, solved bool, date string) error {
+	record := p.Puzzles[id]
+	record.Attempts++
+	record.Solved = record.Solved || solved
+	record.Date = date
+
+	p.Puzzles[id] = record
+	return saveJSON(p.path, p)
+}
+
+// Solved Checks if the puzzle was ever solved.
+func (p *Profile) Solved(id string) bool {
+	return p.Puzzles[id].Solved
+}
+
+// PuzzleStats Get the number of puzzles attempted and solved.
+func (p *Profile) PuzzleStats() (attempted, solved int) {
+	for _, record := range p.Puzzles {
+		attempted++
+		if record.Solved {
+			solved++
+		}
+	}
+	return attempted, solved
+}
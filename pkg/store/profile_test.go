@@ -0,0 +1,39 @@
+package store
+
+import "testing"
+
+func TestProfilePuzzles(t *testing.T) {
+	dir := t.TempDir()
+
+	profile, err := LoadProfile(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	profile.RecordPuzzle("t0001", false, "2022-07-30")
+	profile.RecordPuzzle("t0001", true, "2022-07-31")
+	if err := profile.RecordPuzzle("t0002", false, "2022-07-31"); err != nil {
+		t.Fatal(err)
+	}
+
+	// a later failure doesn't undo a solve
+	profile.RecordPuzzle("t0001", false, "2022-08-01")
+
+	// reload from disk to check the progress was saved
+	profile, err = LoadProfile(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !profile.Solved("t0001") || profile.Solved("t0002") {
+		t.Errorf("expected only t0001 to be solved")
+	}
+
+	if record := profile.Puzzles["t0001"]; record.Attempts != 3 || record.Date != "2022-08-01" {
+		t.Errorf("unexpected record %+v", record)
+	}
+
+	if attempted, solved := profile.PuzzleStats(); attempted != 2 || solved != 1 {
+		t.Errorf("expected 2 attempted and 1 solved but got %d and %d", attempted, solved)
+	}
+}